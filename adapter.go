@@ -19,6 +19,7 @@ import (
 	"time"
 
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/connector"
 )
 
 // Adapter is the high-level interface implemented by adapters.
@@ -31,6 +32,30 @@ type Adapter[Config any] interface {
 	GetPage(ctx context.Context, request *Request[Config]) Response
 }
 
+// PrefetchOptOut may be implemented by an Adapter, or by the Config type of an
+// Adapter, to opt out of the speculative prefetching of next pages when the
+// server is configured to prefetch pages, e.g. if cursors expire quickly or if
+// getting a page has side effects in the datasource.
+// If implemented by the Config type, prefetching is disabled for the
+// datasources which config's PrefetchDisabled method returns true.
+type PrefetchOptOut interface {
+	// PrefetchDisabled returns true if next pages must not be prefetched.
+	PrefetchDisabled() bool
+}
+
+// PageSplitter may be implemented by an Adapter or a ProtoAdapter to split the
+// pages that are larger than the maximum page size in bytes configured on the
+// server, instead of failing the requests for them.
+type PageSplitter[Config any] interface {
+	// SplitPage returns the cursor of the page starting after the first n
+	// objects of the given page returned for the given request, so that only
+	// those n objects are returned, and false if the page cannot be split.
+	// The objects of a page written by a ProtoAdapter are not in the given
+	// page, and are counted in the order they were added.
+	// Called after GetPage has returned, so must not use the datasource.
+	SplitPage(ctx context.Context, request *Request[Config], page *Page, n int) (nextCursor string, ok bool)
+}
+
 // Request is a request for a page of objects from a datasource for an entity.
 //
 // The Config type parameter must be a struct type the configuration
@@ -40,6 +65,24 @@ type Request[Config any] struct {
 	// Required.
 	DatasourceID string `json:"datasourceID"`
 
+	// DatasourceType is the type of the datasource, i.e. the datasource type the
+	// adapter was registered with. Useful to adapters registered with multiple
+	// datasource types.
+	DatasourceType string `json:"datasourceType,omitempty"`
+
+	// TenantID is the identifier of the tenant the request is made for.
+	// Optional.
+	TenantID string `json:"tenantID,omitempty"`
+
+	// ClientID is the identifier of the client the request is made for.
+	// Optional.
+	ClientID string `json:"clientID,omitempty"`
+
+	// ConnectorInfo is the info of the On-Premises Connector used to access the
+	// datasource, also available in the request context via connector.FromContext.
+	// Optional. Nil if the datasource is accessed directly.
+	ConnectorInfo *connector.ConnectorInfo `json:"connectorInfo,omitempty"`
+
 	// Config is configuration for the datasource.
 	// Optional.
 	Config *Config `json:"config,omitempty"`
@@ -70,6 +113,30 @@ type Request[Config any] struct {
 	// the last call to GetPage for the entity.
 	// Optional. If not set, return the first page for this entity.
 	Cursor string `json:"cursor,omitempty"`
+
+	// ChildPage identifies the page of objects of a child entity of a parent
+	// object to return, following the child objects returned in that parent
+	// object. If set, Cursor is ignored, PageSize is the maximum number of
+	// child objects to return, and the page must contain only the parent
+	// object, e.g. as returned by NewChildPageObject.
+	// Optional. Set only for adapters which return child objects with a
+	// next cursor.
+	ChildPage *ChildPageRequest `json:"childPage,omitempty"`
+}
+
+// ChildPageRequest is a request for the next page of objects of a child entity
+// within a parent object.
+type ChildPageRequest struct {
+	// ChildEntityExternalID is the external ID of the child entity, which is a
+	// direct child entity of the requested entity.
+	ChildEntityExternalID string `json:"childEntityExternalID"`
+
+	// ParentID is the value of the unique ID attribute of the parent object.
+	ParentID string `json:"parentID"`
+
+	// Cursor is the next cursor returned for the child entity in the parent
+	// object, e.g. by SplitChildObjects.
+	Cursor string `json:"cursor"`
 }
 
 // DatasourceAuthCredentials contains the credentials to authenticate with a
@@ -144,6 +211,10 @@ const (
 	AttributeTypeInt64 AttributeType = 5
 	// String.
 	AttributeTypeString AttributeType = 6
+	// Binary data.
+	AttributeTypeBytes AttributeType = 7
+	// UUID.
+	AttributeTypeUUID AttributeType = 8
 )
 
 // Response is the response to a GetPage request.
@@ -163,6 +234,11 @@ type Page struct {
 	// NextCursor the cursor that identifies the first object of the next page.
 	// Optional. If not set, this page is the last page for this entity.
 	NextCursor string `json:"nextCursor,omitempty"`
+
+	// Warnings are the non-fatal issues encountered while getting the page,
+	// e.g. objects that were skipped because they were malformed.
+	// Optional.
+	Warnings []Warning `json:"warnings,omitempty"`
 }
 
 // Error contains the details of an error that occurred while executing a
@@ -175,10 +251,46 @@ type Error struct {
 	Message string `json:"message,omitempty"`
 
 	// Code is the error code indicating the cause of the error.
+	// See GetRetryBehavior for how requests failed with each code are retried.
 	Code api_adapter_v1.ErrorCode `json:"code"`
 
 	// RetryAfter is the recommended minimal duration after which this request
 	// may be retried.
 	// Optional.
 	RetryAfter *time.Duration `json:"retryAfter,omitempty"`
+
+	// UpstreamStatusCode is the status code returned by the datasource, e.g. an
+	// HTTP status code.
+	// Optional.
+	UpstreamStatusCode int `json:"upstreamStatusCode,omitempty"`
+
+	// UpstreamRequestID is the ID of the request sent to the datasource, as
+	// returned by the datasource, e.g. in an X-Request-Id HTTP response header.
+	// Optional.
+	UpstreamRequestID string `json:"upstreamRequestId,omitempty"`
+
+	// AttributeExternalID is the external ID of the attribute involved in the
+	// error.
+	// Optional.
+	AttributeExternalID string `json:"attributeExternalId,omitempty"`
+
+	// EntityExternalID is the external ID of the entity or child entity
+	// involved in the error.
+	// Optional.
+	EntityExternalID string `json:"entityExternalId,omitempty"`
+
+	// Reason is a machine-readable reason for the error, in UPPER_SNAKE_CASE,
+	// more specific than Code, e.g. "HTTP_UNAUTHORIZED".
+	// Optional.
+	Reason string `json:"reason,omitempty"`
+
+	// Transient indicates whether the error is likely to not occur again if the
+	// request is retried.
+	// Optional.
+	Transient bool `json:"transient,omitempty"`
+
+	// SuggestedPageSize is the page size with which the request is expected to
+	// succeed if retried, e.g. if the page was too large to be returned.
+	// Optional.
+	SuggestedPageSize int64 `json:"suggestedPageSize,omitempty"`
 }
@@ -38,6 +38,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The type of a source accessed via an On-Premises Connector.
+type ConnectorSourceType int32
+
+const (
+	ConnectorSourceType_CONNECTOR_SOURCE_TYPE_UNSPECIFIED ConnectorSourceType = 0
+	// A datasource.
+	ConnectorSourceType_CONNECTOR_SOURCE_TYPE_DATASOURCE ConnectorSourceType = 1
+	// An integration.
+	ConnectorSourceType_CONNECTOR_SOURCE_TYPE_INTEGRATION ConnectorSourceType = 2
+)
+
+// Enum value maps for ConnectorSourceType.
+var (
+	ConnectorSourceType_name = map[int32]string{
+		0: "CONNECTOR_SOURCE_TYPE_UNSPECIFIED",
+		1: "CONNECTOR_SOURCE_TYPE_DATASOURCE",
+		2: "CONNECTOR_SOURCE_TYPE_INTEGRATION",
+	}
+	ConnectorSourceType_value = map[string]int32{
+		"CONNECTOR_SOURCE_TYPE_UNSPECIFIED": 0,
+		"CONNECTOR_SOURCE_TYPE_DATASOURCE":  1,
+		"CONNECTOR_SOURCE_TYPE_INTEGRATION": 2,
+	}
+)
+
+func (x ConnectorSourceType) Enum() *ConnectorSourceType {
+	p := new(ConnectorSourceType)
+	*p = x
+	return p
+}
+
+func (x ConnectorSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectorSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_adapter_v1_adapter_proto_enumTypes[0].Descriptor()
+}
+
+func (ConnectorSourceType) Type() protoreflect.EnumType {
+	return &file_api_adapter_v1_adapter_proto_enumTypes[0]
+}
+
+func (x ConnectorSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectorSourceType.Descriptor instead.
+func (ConnectorSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{0}
+}
+
 // The type of the values for an attribute.
 type AttributeType int32
 
@@ -56,6 +108,10 @@ const (
 	AttributeType_ATTRIBUTE_TYPE_INT64 AttributeType = 5
 	// String.
 	AttributeType_ATTRIBUTE_TYPE_STRING AttributeType = 6
+	// Binary data.
+	AttributeType_ATTRIBUTE_TYPE_BYTES AttributeType = 7
+	// UUID.
+	AttributeType_ATTRIBUTE_TYPE_UUID AttributeType = 8
 )
 
 // Enum value maps for AttributeType.
@@ -68,6 +124,8 @@ var (
 		4: "ATTRIBUTE_TYPE_DURATION",
 		5: "ATTRIBUTE_TYPE_INT64",
 		6: "ATTRIBUTE_TYPE_STRING",
+		7: "ATTRIBUTE_TYPE_BYTES",
+		8: "ATTRIBUTE_TYPE_UUID",
 	}
 	AttributeType_value = map[string]int32{
 		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
@@ -77,6 +135,8 @@ var (
 		"ATTRIBUTE_TYPE_DURATION":    4,
 		"ATTRIBUTE_TYPE_INT64":       5,
 		"ATTRIBUTE_TYPE_STRING":      6,
+		"ATTRIBUTE_TYPE_BYTES":       7,
+		"ATTRIBUTE_TYPE_UUID":        8,
 	}
 )
 
@@ -91,11 +151,11 @@ func (x AttributeType) String() string {
 }
 
 func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_adapter_v1_adapter_proto_enumTypes[0].Descriptor()
+	return file_api_adapter_v1_adapter_proto_enumTypes[1].Descriptor()
 }
 
 func (AttributeType) Type() protoreflect.EnumType {
-	return &file_api_adapter_v1_adapter_proto_enumTypes[0]
+	return &file_api_adapter_v1_adapter_proto_enumTypes[1]
 }
 
 func (x AttributeType) Number() protoreflect.EnumNumber {
@@ -104,7 +164,67 @@ func (x AttributeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AttributeType.Descriptor instead.
 func (AttributeType) EnumDescriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{0}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{1}
+}
+
+type WarningCode int32
+
+const (
+	// Invalid. Must not be used.
+	WarningCode_WARNING_CODE_UNSPECIFIED WarningCode = 0
+	// An object returned by the datasource was malformed and was skipped.
+	WarningCode_WARNING_CODE_OBJECT_SKIPPED WarningCode = 1
+	// An attribute value returned by the datasource was malformed and was skipped.
+	WarningCode_WARNING_CODE_ATTRIBUTE_SKIPPED WarningCode = 2
+	// The values of a multi-valued attribute were truncated.
+	WarningCode_WARNING_CODE_ATTRIBUTE_TRUNCATED WarningCode = 3
+	// The datasource API used by the adapter is deprecated.
+	WarningCode_WARNING_CODE_DEPRECATED_API WarningCode = 4
+)
+
+// Enum value maps for WarningCode.
+var (
+	WarningCode_name = map[int32]string{
+		0: "WARNING_CODE_UNSPECIFIED",
+		1: "WARNING_CODE_OBJECT_SKIPPED",
+		2: "WARNING_CODE_ATTRIBUTE_SKIPPED",
+		3: "WARNING_CODE_ATTRIBUTE_TRUNCATED",
+		4: "WARNING_CODE_DEPRECATED_API",
+	}
+	WarningCode_value = map[string]int32{
+		"WARNING_CODE_UNSPECIFIED":         0,
+		"WARNING_CODE_OBJECT_SKIPPED":      1,
+		"WARNING_CODE_ATTRIBUTE_SKIPPED":   2,
+		"WARNING_CODE_ATTRIBUTE_TRUNCATED": 3,
+		"WARNING_CODE_DEPRECATED_API":      4,
+	}
+)
+
+func (x WarningCode) Enum() *WarningCode {
+	p := new(WarningCode)
+	*p = x
+	return p
+}
+
+func (x WarningCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WarningCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_adapter_v1_adapter_proto_enumTypes[2].Descriptor()
+}
+
+func (WarningCode) Type() protoreflect.EnumType {
+	return &file_api_adapter_v1_adapter_proto_enumTypes[2]
+}
+
+func (x WarningCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WarningCode.Descriptor instead.
+func (WarningCode) EnumDescriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{2}
 }
 
 // Error codes indicating why the page request failed.
@@ -137,6 +257,17 @@ const (
 	ErrorCode_ERROR_CODE_INTERNAL ErrorCode = 11
 	// Datasource received too many requests.
 	ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS ErrorCode = 12
+	// The cursor of the request is no longer valid, e.g. because it expired.
+	// The request must not be retried with the same cursor: the entity must be
+	// restarted from the first page, without a cursor.
+	ErrorCode_ERROR_CODE_CURSOR_EXPIRED ErrorCode = 13
+	// The requested entity does not exist in the datasource.
+	// The request must not be retried until the entity config is changed.
+	ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND ErrorCode = 14
+	// Access was granted to some of the requested objects but not all.
+	// The request must not be retried until the permissions granted to the
+	// datasource credentials are changed.
+	ErrorCode_ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS ErrorCode = 15
 )
 
 // Enum value maps for ErrorCode.
@@ -155,6 +286,9 @@ var (
 		10: "ERROR_CODE_DATASOURCE_FAILED",
 		11: "ERROR_CODE_INTERNAL",
 		12: "ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS",
+		13: "ERROR_CODE_CURSOR_EXPIRED",
+		14: "ERROR_CODE_ENTITY_NOT_FOUND",
+		15: "ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":                        0,
@@ -170,6 +304,9 @@ var (
 		"ERROR_CODE_DATASOURCE_FAILED":                  10,
 		"ERROR_CODE_INTERNAL":                           11,
 		"ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS":       12,
+		"ERROR_CODE_CURSOR_EXPIRED":                     13,
+		"ERROR_CODE_ENTITY_NOT_FOUND":                   14,
+		"ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS":     15,
 	}
 )
 
@@ -184,11 +321,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_adapter_v1_adapter_proto_enumTypes[1].Descriptor()
+	return file_api_adapter_v1_adapter_proto_enumTypes[3].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_api_adapter_v1_adapter_proto_enumTypes[1]
+	return &file_api_adapter_v1_adapter_proto_enumTypes[3]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -197,7 +334,7 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{1}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{3}
 }
 
 // A request for a page of data.
@@ -207,9 +344,12 @@ type GetPageRequest struct {
 	Datasource *DatasourceConfig `protobuf:"bytes,1,opt,name=datasource,proto3" json:"datasource,omitempty"`
 	// The entity to return a page of objects from.
 	Entity *EntityConfig `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
-	// The maximum number of objects to return from the entity.
-	// The number of child_objects in each object is not limited:
-	// all the objects from child entities are returned in each parent object.
+	// The maximum number of objects to return from the entity, or from the
+	// child entity if child_page is set.
+	// The number of child_objects in each object is not limited, unless the
+	// adapter splits them across pages: the objects from child entities are
+	// returned in each parent object, and the next_cursor of their
+	// EntityObjects identifies the remaining objects, if any.
 	PageSize int64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// The cursor that identifies the first object of the page to return, as
 	// returned by the last call to GetPage for the entity.
@@ -220,7 +360,14 @@ type GetPageRequest struct {
 	TenantId string `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	// The client identifier associated with this request.
 	// Optional.
-	ClientId      string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The page of objects of a child entity of a parent object to return,
+	// following the child objects returned in that parent object.
+	// If set, cursor is ignored and the response contains only the parent
+	// object, with its unique ID attribute and the page of objects of the
+	// child entity.
+	// Optional.
+	ChildPage     *ChildPageRequest `protobuf:"bytes,7,opt,name=child_page,json=childPage,proto3" json:"child_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -283,47 +430,484 @@ func (x *GetPageRequest) GetCursor() string {
 	return ""
 }
 
-func (x *GetPageRequest) GetTenantId() string {
+func (x *GetPageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetPageRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetPageRequest) GetChildPage() *ChildPageRequest {
+	if x != nil {
+		return x.ChildPage
+	}
+	return nil
+}
+
+// A request for the next page of objects of a child entity within a parent
+// object.
+type ChildPageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the child entity, which must be a direct child entity of the
+	// requested entity.
+	ChildEntityId string `protobuf:"bytes,1,opt,name=child_entity_id,json=childEntityId,proto3" json:"child_entity_id,omitempty"`
+	// The value of the unique ID attribute of the parent object.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// The next_cursor of the EntityObjects returned for the child entity in
+	// the parent object.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChildPageRequest) Reset() {
+	*x = ChildPageRequest{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildPageRequest) ProtoMessage() {}
+
+func (x *ChildPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildPageRequest.ProtoReflect.Descriptor instead.
+func (*ChildPageRequest) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChildPageRequest) GetChildEntityId() string {
+	if x != nil {
+		return x.ChildEntityId
+	}
+	return ""
+}
+
+func (x *ChildPageRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ChildPageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// A response containing a page of data.
+type GetPageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Response:
+	//
+	//	*GetPageResponse_Success
+	//	*GetPageResponse_Error
+	Response      isGetPageResponse_Response `protobuf_oneof:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPageResponse) Reset() {
+	*x = GetPageResponse{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPageResponse) ProtoMessage() {}
+
+func (x *GetPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPageResponse.ProtoReflect.Descriptor instead.
+func (*GetPageResponse) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPageResponse) GetResponse() isGetPageResponse_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *GetPageResponse) GetSuccess() *Page {
+	if x != nil {
+		if x, ok := x.Response.(*GetPageResponse_Success); ok {
+			return x.Success
+		}
+	}
+	return nil
+}
+
+func (x *GetPageResponse) GetError() *Error {
+	if x != nil {
+		if x, ok := x.Response.(*GetPageResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isGetPageResponse_Response interface {
+	isGetPageResponse_Response()
+}
+
+type GetPageResponse_Success struct {
+	Success *Page `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type GetPageResponse_Error struct {
+	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GetPageResponse_Success) isGetPageResponse_Response() {}
+
+func (*GetPageResponse_Error) isGetPageResponse_Response() {}
+
+// A request for the capabilities of the adapter.
+type GetCapabilitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The datasource types to return the capabilities of.
+	// If empty, return the capabilities of all the supported datasource types.
+	// Unsupported datasource types are ignored.
+	DatasourceTypes []string `protobuf:"bytes,1,rep,name=datasource_types,json=datasourceTypes,proto3" json:"datasource_types,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCapabilitiesRequest) GetDatasourceTypes() []string {
+	if x != nil {
+		return x.DatasourceTypes
+	}
+	return nil
+}
+
+// A response containing the capabilities of the adapter.
+type GetCapabilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The capabilities of each requested datasource type supported by the
+	// adapter, sorted by datasource type.
+	Datasources   []*DatasourceCapabilities `protobuf:"bytes,1,rep,name=datasources,proto3" json:"datasources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCapabilitiesResponse) GetDatasources() []*DatasourceCapabilities {
+	if x != nil {
+		return x.Datasources
+	}
+	return nil
+}
+
+// The capabilities of the adapter for a datasource type.
+type DatasourceCapabilities struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The datasource type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The JSON Schema of the adapter-specific configuration of datasources of
+	// this type, marshaled as JSON.
+	ConfigSchema []byte `protobuf:"bytes,2,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	// The latest version of the adapter-specific configuration of datasources
+	// of this type, i.e. the value of its top-level "version" field.
+	ConfigVersion int64 `protobuf:"varint,3,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	// The entities supported by the adapter for datasources of this type.
+	// Empty if the adapter doesn't declare the entities it supports.
+	Entities      []*EntityCapabilities `protobuf:"bytes,4,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasourceCapabilities) Reset() {
+	*x = DatasourceCapabilities{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasourceCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasourceCapabilities) ProtoMessage() {}
+
+func (x *DatasourceCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasourceCapabilities.ProtoReflect.Descriptor instead.
+func (*DatasourceCapabilities) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DatasourceCapabilities) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DatasourceCapabilities) GetConfigSchema() []byte {
+	if x != nil {
+		return x.ConfigSchema
+	}
+	return nil
+}
+
+func (x *DatasourceCapabilities) GetConfigVersion() int64 {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return 0
+}
+
+func (x *DatasourceCapabilities) GetEntities() []*EntityCapabilities {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// An entity, or a set of entities, supported by an adapter.
+type EntityCapabilities struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The external ID of the entity, or a pattern matching the external IDs of
+	// the entities if pattern is true.
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// Whether external_id is a pattern, in which '*' matches any sequence of
+	// characters other than '/', '?' matches any single such character, and
+	// '[...]' matches a character class.
+	Pattern       bool `protobuf:"varint,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityCapabilities) Reset() {
+	*x = EntityCapabilities{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityCapabilities) ProtoMessage() {}
+
+func (x *EntityCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityCapabilities.ProtoReflect.Descriptor instead.
+func (*EntityCapabilities) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EntityCapabilities) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *EntityCapabilities) GetPattern() bool {
+	if x != nil {
+		return x.Pattern
+	}
+	return false
+}
+
+// A request to validate a datasource config.
+type ValidateConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The datasource to validate the config of.
+	Datasource    *DatasourceConfig `protobuf:"bytes,1,opt,name=datasource,proto3" json:"datasource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigRequest) Reset() {
+	*x = ValidateConfigRequest{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigRequest) ProtoMessage() {}
+
+func (x *ValidateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[7]
 	if x != nil {
-		return x.TenantId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetPageRequest) GetClientId() string {
+// Deprecated: Use ValidateConfigRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateConfigRequest) GetDatasource() *DatasourceConfig {
 	if x != nil {
-		return x.ClientId
+		return x.Datasource
 	}
-	return ""
+	return nil
 }
 
-// A response containing a page of data.
-type GetPageResponse struct {
+// A response containing the result of the validation of a datasource config.
+type ValidateConfigResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Response:
-	//
-	//	*GetPageResponse_Success
-	//	*GetPageResponse_Error
-	Response      isGetPageResponse_Response `protobuf_oneof:"response"`
+	// The adapter-specific configuration migrated to the latest version.
+	// Secret references in the configuration are not resolved.
+	// Not set if the configuration could not be migrated.
+	Config []byte `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The version of the adapter-specific configuration in the request.
+	OriginalConfigVersion int64 `protobuf:"varint,2,opt,name=original_config_version,json=originalConfigVersion,proto3" json:"original_config_version,omitempty"`
+	// The latest version of the adapter-specific configuration.
+	ConfigVersion int64 `protobuf:"varint,3,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	// The error if the configuration is invalid.
+	Error         *Error `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPageResponse) Reset() {
-	*x = GetPageResponse{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[1]
+func (x *ValidateConfigResponse) Reset() {
+	*x = ValidateConfigResponse{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPageResponse) String() string {
+func (x *ValidateConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPageResponse) ProtoMessage() {}
+func (*ValidateConfigResponse) ProtoMessage() {}
 
-func (x *GetPageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[1]
+func (x *ValidateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,52 +918,39 @@ func (x *GetPageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPageResponse.ProtoReflect.Descriptor instead.
-func (*GetPageResponse) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ValidateConfigResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetPageResponse) GetResponse() isGetPageResponse_Response {
+func (x *ValidateConfigResponse) GetConfig() []byte {
 	if x != nil {
-		return x.Response
+		return x.Config
 	}
 	return nil
 }
 
-func (x *GetPageResponse) GetSuccess() *Page {
+func (x *ValidateConfigResponse) GetOriginalConfigVersion() int64 {
 	if x != nil {
-		if x, ok := x.Response.(*GetPageResponse_Success); ok {
-			return x.Success
-		}
+		return x.OriginalConfigVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *GetPageResponse) GetError() *Error {
+func (x *ValidateConfigResponse) GetConfigVersion() int64 {
 	if x != nil {
-		if x, ok := x.Response.(*GetPageResponse_Error); ok {
-			return x.Error
-		}
+		return x.ConfigVersion
 	}
-	return nil
-}
-
-type isGetPageResponse_Response interface {
-	isGetPageResponse_Response()
-}
-
-type GetPageResponse_Success struct {
-	Success *Page `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+	return 0
 }
 
-type GetPageResponse_Error struct {
-	Error *Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *ValidateConfigResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
 }
 
-func (*GetPageResponse_Success) isGetPageResponse_Response() {}
-
-func (*GetPageResponse_Error) isGetPageResponse_Response() {}
-
 // The configuration of a datasource to get entity data from.
 type DatasourceConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -406,7 +977,7 @@ type DatasourceConfig struct {
 
 func (x *DatasourceConfig) Reset() {
 	*x = DatasourceConfig{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[2]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +989,7 @@ func (x *DatasourceConfig) String() string {
 func (*DatasourceConfig) ProtoMessage() {}
 
 func (x *DatasourceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[2]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +1002,7 @@ func (x *DatasourceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatasourceConfig.ProtoReflect.Descriptor instead.
 func (*DatasourceConfig) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{2}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DatasourceConfig) GetId() string {
@@ -483,14 +1054,21 @@ type ConnectorInfo struct {
 	// The tenant identifier to which the connector is associated.
 	TenantId string `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	// The client identifier to which the connector is associated.
-	ClientId      string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientId string `protobuf:"bytes,8,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The type of the source the connector is used to access.
+	// If not set, the source is the datasource containing this info.
+	SourceType ConnectorSourceType `protobuf:"varint,9,opt,name=source_type,json=sourceType,proto3,enum=sgnl.adapter.v1.ConnectorSourceType" json:"source_type,omitempty"`
+	// The unique identifier of the source the connector is used to access,
+	// e.g. the ID of the datasource.
+	// If not set, the source is the datasource containing this info.
+	SourceId      string `protobuf:"bytes,10,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ConnectorInfo) Reset() {
 	*x = ConnectorInfo{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[3]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +1080,7 @@ func (x *ConnectorInfo) String() string {
 func (*ConnectorInfo) ProtoMessage() {}
 
 func (x *ConnectorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[3]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +1093,7 @@ func (x *ConnectorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectorInfo.ProtoReflect.Descriptor instead.
 func (*ConnectorInfo) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{3}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ConnectorInfo) GetId() string {
@@ -539,6 +1117,20 @@ func (x *ConnectorInfo) GetClientId() string {
 	return ""
 }
 
+func (x *ConnectorInfo) GetSourceType() ConnectorSourceType {
+	if x != nil {
+		return x.SourceType
+	}
+	return ConnectorSourceType_CONNECTOR_SOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ConnectorInfo) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
 // Credentials to use to authenticate with a datasource.
 type DatasourceAuthCredentials struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -556,7 +1148,7 @@ type DatasourceAuthCredentials struct {
 
 func (x *DatasourceAuthCredentials) Reset() {
 	*x = DatasourceAuthCredentials{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[4]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1160,7 @@ func (x *DatasourceAuthCredentials) String() string {
 func (*DatasourceAuthCredentials) ProtoMessage() {}
 
 func (x *DatasourceAuthCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[4]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1173,7 @@ func (x *DatasourceAuthCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatasourceAuthCredentials.ProtoReflect.Descriptor instead.
 func (*DatasourceAuthCredentials) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{4}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DatasourceAuthCredentials) GetAuthMechanism() isDatasourceAuthCredentials_AuthMechanism {
@@ -650,7 +1242,7 @@ type EntityConfig struct {
 
 func (x *EntityConfig) Reset() {
 	*x = EntityConfig{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[5]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +1254,7 @@ func (x *EntityConfig) String() string {
 func (*EntityConfig) ProtoMessage() {}
 
 func (x *EntityConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[5]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +1267,7 @@ func (x *EntityConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityConfig.ProtoReflect.Descriptor instead.
 func (*EntityConfig) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{5}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EntityConfig) GetId() string {
@@ -735,7 +1327,7 @@ type AttributeConfig struct {
 
 func (x *AttributeConfig) Reset() {
 	*x = AttributeConfig{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[6]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -747,7 +1339,7 @@ func (x *AttributeConfig) String() string {
 func (*AttributeConfig) ProtoMessage() {}
 
 func (x *AttributeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[6]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +1352,7 @@ func (x *AttributeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributeConfig.ProtoReflect.Descriptor instead.
 func (*AttributeConfig) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{6}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AttributeConfig) GetId() string {
@@ -807,14 +1399,17 @@ type Page struct {
 	Objects []*Object `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
 	// The cursor that identifies the first object of the next page.
 	// If not set, this page is the last page for this entity.
-	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// The non-fatal issues encountered while getting the page, e.g. objects that
+	// were skipped because they were malformed.
+	Warnings      []*Warning `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Page) Reset() {
 	*x = Page{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[7]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -826,7 +1421,7 @@ func (x *Page) String() string {
 func (*Page) ProtoMessage() {}
 
 func (x *Page) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[7]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,7 +1434,7 @@ func (x *Page) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Page.ProtoReflect.Descriptor instead.
 func (*Page) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{7}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Page) GetObjects() []*Object {
@@ -856,6 +1451,87 @@ func (x *Page) GetNextCursor() string {
 	return ""
 }
 
+func (x *Page) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// A non-fatal issue encountered while getting a page.
+type Warning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The warning code indicating the cause of the warning.
+	Code WarningCode `protobuf:"varint,1,opt,name=code,proto3,enum=sgnl.adapter.v1.WarningCode" json:"code,omitempty"`
+	// The warning message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The unique ID of the object involved in the warning, i.e. the value of the
+	// entity's unique ID attribute. Optional.
+	ObjectId string `protobuf:"bytes,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// The external ID of the attribute involved in the warning. Optional.
+	AttributeExternalId string `protobuf:"bytes,4,opt,name=attribute_external_id,json=attributeExternalId,proto3" json:"attribute_external_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Warning) Reset() {
+	*x = Warning{}
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warning) ProtoMessage() {}
+
+func (x *Warning) ProtoReflect() protoreflect.Message {
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Warning) GetCode() WarningCode {
+	if x != nil {
+		return x.Code
+	}
+	return WarningCode_WARNING_CODE_UNSPECIFIED
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Warning) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *Warning) GetAttributeExternalId() string {
+	if x != nil {
+		return x.AttributeExternalId
+	}
+	return ""
+}
+
 // An object and its child objects.
 type Object struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -870,7 +1546,7 @@ type Object struct {
 
 func (x *Object) Reset() {
 	*x = Object{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[8]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -882,7 +1558,7 @@ func (x *Object) String() string {
 func (*Object) ProtoMessage() {}
 
 func (x *Object) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[8]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,7 +1571,7 @@ func (x *Object) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object.ProtoReflect.Descriptor instead.
 func (*Object) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{8}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Object) GetAttributes() []*Attribute {
@@ -918,14 +1594,19 @@ type EntityObjects struct {
 	// The ID of the entity the objects belong to.
 	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	// The set of objects.
-	Objects       []*Object `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	Objects []*Object `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	// The cursor that identifies the first object of the next page of objects
+	// of the entity in the parent object, to request with a ChildPageRequest.
+	// If not set, all the objects of the entity in the parent object were
+	// returned.
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EntityObjects) Reset() {
 	*x = EntityObjects{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[9]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1618,7 @@ func (x *EntityObjects) String() string {
 func (*EntityObjects) ProtoMessage() {}
 
 func (x *EntityObjects) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[9]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1631,7 @@ func (x *EntityObjects) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityObjects.ProtoReflect.Descriptor instead.
 func (*EntityObjects) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{9}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EntityObjects) GetEntityId() string {
@@ -967,6 +1648,13 @@ func (x *EntityObjects) GetObjects() []*Object {
 	return nil
 }
 
+func (x *EntityObjects) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // An attribute of an object.
 type Attribute struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -982,7 +1670,7 @@ type Attribute struct {
 
 func (x *Attribute) Reset() {
 	*x = Attribute{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[10]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1682,7 @@ func (x *Attribute) String() string {
 func (*Attribute) ProtoMessage() {}
 
 func (x *Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[10]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1695,7 @@ func (x *Attribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{10}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Attribute) GetId() string {
@@ -1036,6 +1724,8 @@ type AttributeValue struct {
 	//	*AttributeValue_DurationValue
 	//	*AttributeValue_Int64Value
 	//	*AttributeValue_StringValue
+	//	*AttributeValue_BytesValue
+	//	*AttributeValue_UuidValue
 	Value         isAttributeValue_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1043,7 +1733,7 @@ type AttributeValue struct {
 
 func (x *AttributeValue) Reset() {
 	*x = AttributeValue{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[11]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1745,7 @@ func (x *AttributeValue) String() string {
 func (*AttributeValue) ProtoMessage() {}
 
 func (x *AttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[11]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1758,7 @@ func (x *AttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributeValue.ProtoReflect.Descriptor instead.
 func (*AttributeValue) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{11}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AttributeValue) GetValue() isAttributeValue_Value {
@@ -1141,6 +1831,24 @@ func (x *AttributeValue) GetStringValue() string {
 	return ""
 }
 
+func (x *AttributeValue) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Value.(*AttributeValue_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetUuidValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*AttributeValue_UuidValue); ok {
+			return x.UuidValue
+		}
+	}
+	return ""
+}
+
 type isAttributeValue_Value interface {
 	isAttributeValue_Value()
 }
@@ -1173,6 +1881,16 @@ type AttributeValue_StringValue struct {
 	StringValue string `protobuf:"bytes,7,opt,name=string_value,json=stringValue,proto3,oneof"`
 }
 
+type AttributeValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,8,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type AttributeValue_UuidValue struct {
+	// A UUID in its canonical form, e.g. "123e4567-e89b-12d3-a456-426614174000",
+	// with lower-case hexadecimal digits.
+	UuidValue string `protobuf:"bytes,9,opt,name=uuid_value,json=uuidValue,proto3,oneof"`
+}
+
 func (*AttributeValue_NullValue) isAttributeValue_Value() {}
 
 func (*AttributeValue_BoolValue) isAttributeValue_Value() {}
@@ -1187,6 +1905,10 @@ func (*AttributeValue_Int64Value) isAttributeValue_Value() {}
 
 func (*AttributeValue_StringValue) isAttributeValue_Value() {}
 
+func (*AttributeValue_BytesValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_UuidValue) isAttributeValue_Value() {}
+
 // A duration, as the sum of all the fields' durations.
 // Each field may be positive, zero, or negative.
 // The seconds and nanos fields have the same tags as in google.protobuf.Duration for backward compatibility:
@@ -1208,7 +1930,7 @@ type Duration struct {
 
 func (x *Duration) Reset() {
 	*x = Duration{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[12]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1942,7 @@ func (x *Duration) String() string {
 func (*Duration) ProtoMessage() {}
 
 func (x *Duration) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[12]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1955,7 @@ func (x *Duration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Duration.ProtoReflect.Descriptor instead.
 func (*Duration) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{12}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Duration) GetSeconds() int64 {
@@ -1278,7 +2000,7 @@ type DateTime struct {
 
 func (x *DateTime) Reset() {
 	*x = DateTime{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[13]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1290,7 +2012,7 @@ func (x *DateTime) String() string {
 func (*DateTime) ProtoMessage() {}
 
 func (x *DateTime) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[13]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +2025,7 @@ func (x *DateTime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTime.ProtoReflect.Descriptor instead.
 func (*DateTime) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{13}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DateTime) GetTimestamp() *timestamppb.Timestamp {
@@ -1328,14 +2050,32 @@ type Error struct {
 	// The error code indicating the cause of the error.
 	Code ErrorCode `protobuf:"varint,2,opt,name=code,proto3,enum=sgnl.adapter.v1.ErrorCode" json:"code,omitempty"`
 	// Recommended minimal duration after which this request may be retried. Optional.
-	RetryAfter    *durationpb.Duration `protobuf:"bytes,3,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RetryAfter *durationpb.Duration `protobuf:"bytes,3,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	// The status code returned by the datasource, e.g. an HTTP status code. Optional.
+	UpstreamStatusCode int32 `protobuf:"varint,4,opt,name=upstream_status_code,json=upstreamStatusCode,proto3" json:"upstream_status_code,omitempty"`
+	// The ID of the request sent to the datasource, as returned by the datasource, e.g. in
+	// an X-Request-Id HTTP response header. Optional.
+	UpstreamRequestId string `protobuf:"bytes,5,opt,name=upstream_request_id,json=upstreamRequestId,proto3" json:"upstream_request_id,omitempty"`
+	// The external ID of the attribute involved in the error. Optional.
+	AttributeExternalId string `protobuf:"bytes,6,opt,name=attribute_external_id,json=attributeExternalId,proto3" json:"attribute_external_id,omitempty"`
+	// The external ID of the entity or child entity involved in the error. Optional.
+	EntityExternalId string `protobuf:"bytes,7,opt,name=entity_external_id,json=entityExternalId,proto3" json:"entity_external_id,omitempty"`
+	// A machine-readable reason for the error, in UPPER_SNAKE_CASE, more specific than
+	// the error code. Optional.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the error is likely to be transient, i.e. to not occur again if the request
+	// is retried. Optional.
+	Transient bool `protobuf:"varint,9,opt,name=transient,proto3" json:"transient,omitempty"`
+	// The page size with which this request is expected to succeed if retried, e.g.
+	// if the page was too large to be returned. Optional.
+	SuggestedPageSize int64 `protobuf:"varint,10,opt,name=suggested_page_size,json=suggestedPageSize,proto3" json:"suggested_page_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Error) Reset() {
 	*x = Error{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[14]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +2087,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[14]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +2100,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{14}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Error) GetMessage() string {
@@ -1384,6 +2124,55 @@ func (x *Error) GetRetryAfter() *durationpb.Duration {
 	return nil
 }
 
+func (x *Error) GetUpstreamStatusCode() int32 {
+	if x != nil {
+		return x.UpstreamStatusCode
+	}
+	return 0
+}
+
+func (x *Error) GetUpstreamRequestId() string {
+	if x != nil {
+		return x.UpstreamRequestId
+	}
+	return ""
+}
+
+func (x *Error) GetAttributeExternalId() string {
+	if x != nil {
+		return x.AttributeExternalId
+	}
+	return ""
+}
+
+func (x *Error) GetEntityExternalId() string {
+	if x != nil {
+		return x.EntityExternalId
+	}
+	return ""
+}
+
+func (x *Error) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Error) GetTransient() bool {
+	if x != nil {
+		return x.Transient
+	}
+	return false
+}
+
+func (x *Error) GetSuggestedPageSize() int64 {
+	if x != nil {
+		return x.SuggestedPageSize
+	}
+	return 0
+}
+
 // Basic authentication credentials.
 type DatasourceAuthCredentials_Basic struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1395,7 +2184,7 @@ type DatasourceAuthCredentials_Basic struct {
 
 func (x *DatasourceAuthCredentials_Basic) Reset() {
 	*x = DatasourceAuthCredentials_Basic{}
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[15]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1407,7 +2196,7 @@ func (x *DatasourceAuthCredentials_Basic) String() string {
 func (*DatasourceAuthCredentials_Basic) ProtoMessage() {}
 
 func (x *DatasourceAuthCredentials_Basic) ProtoReflect() protoreflect.Message {
-	mi := &file_api_adapter_v1_adapter_proto_msgTypes[15]
+	mi := &file_api_adapter_v1_adapter_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1420,7 +2209,7 @@ func (x *DatasourceAuthCredentials_Basic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatasourceAuthCredentials_Basic.ProtoReflect.Descriptor instead.
 func (*DatasourceAuthCredentials_Basic) Descriptor() ([]byte, []int) {
-	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{4, 0}
+	return file_api_adapter_v1_adapter_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *DatasourceAuthCredentials_Basic) GetUsername() string {
@@ -1441,7 +2230,7 @@ var File_api_adapter_v1_adapter_proto protoreflect.FileDescriptor
 
 const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/adapter/v1/adapter.proto\x12\x0fsgnl.adapter.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x01\n" +
+	"\x1capi/adapter/v1/adapter.proto\x12\x0fsgnl.adapter.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x02\n" +
 	"\x0eGetPageRequest\x12A\n" +
 	"\n" +
 	"datasource\x18\x01 \x01(\v2!.sgnl.adapter.v1.DatasourceConfigR\n" +
@@ -1450,23 +2239,55 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12\x16\n" +
 	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x1b\n" +
 	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12\x1b\n" +
-	"\tclient_id\x18\x06 \x01(\tR\bclientId\"\x80\x01\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\x12@\n" +
+	"\n" +
+	"child_page\x18\a \x01(\v2!.sgnl.adapter.v1.ChildPageRequestR\tchildPage\"o\n" +
+	"\x10ChildPageRequest\x12&\n" +
+	"\x0fchild_entity_id\x18\x01 \x01(\tR\rchildEntityId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x80\x01\n" +
 	"\x0fGetPageResponse\x121\n" +
 	"\asuccess\x18\x01 \x01(\v2\x15.sgnl.adapter.v1.PageH\x00R\asuccess\x12.\n" +
 	"\x05error\x18\x02 \x01(\v2\x16.sgnl.adapter.v1.ErrorH\x00R\x05errorB\n" +
 	"\n" +
-	"\bresponse\"\xef\x01\n" +
+	"\bresponse\"C\n" +
+	"\x16GetCapabilitiesRequest\x12)\n" +
+	"\x10datasource_types\x18\x01 \x03(\tR\x0fdatasourceTypes\"d\n" +
+	"\x17GetCapabilitiesResponse\x12I\n" +
+	"\vdatasources\x18\x01 \x03(\v2'.sgnl.adapter.v1.DatasourceCapabilitiesR\vdatasources\"\xb9\x01\n" +
+	"\x16DatasourceCapabilities\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12#\n" +
+	"\rconfig_schema\x18\x02 \x01(\fR\fconfigSchema\x12%\n" +
+	"\x0econfig_version\x18\x03 \x01(\x03R\rconfigVersion\x12?\n" +
+	"\bentities\x18\x04 \x03(\v2#.sgnl.adapter.v1.EntityCapabilitiesR\bentities\"O\n" +
+	"\x12EntityCapabilities\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\bR\apattern\"Z\n" +
+	"\x15ValidateConfigRequest\x12A\n" +
+	"\n" +
+	"datasource\x18\x01 \x01(\v2!.sgnl.adapter.v1.DatasourceConfigR\n" +
+	"datasource\"\xbd\x01\n" +
+	"\x16ValidateConfigResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\fR\x06config\x126\n" +
+	"\x17original_config_version\x18\x02 \x01(\x03R\x15originalConfigVersion\x12%\n" +
+	"\x0econfig_version\x18\x03 \x01(\x03R\rconfigVersion\x12,\n" +
+	"\x05error\x18\x04 \x01(\v2\x16.sgnl.adapter.v1.ErrorR\x05error\"\xef\x01\n" +
 	"\x10DatasourceConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06config\x18\x02 \x01(\fR\x06config\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12>\n" +
 	"\x04auth\x18\x04 \x01(\v2*.sgnl.adapter.v1.DatasourceAuthCredentialsR\x04auth\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\tR\x04type\x12E\n" +
-	"\x0econnector_info\x18\x06 \x01(\v2\x1e.sgnl.adapter.v1.ConnectorInfoR\rconnectorInfo\"Y\n" +
+	"\x0econnector_info\x18\x06 \x01(\v2\x1e.sgnl.adapter.v1.ConnectorInfoR\rconnectorInfo\"\xbd\x01\n" +
 	"\rConnectorInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\a \x01(\tR\btenantId\x12\x1b\n" +
-	"\tclient_id\x18\b \x01(\tR\bclientId\"\xe9\x01\n" +
+	"\tclient_id\x18\b \x01(\tR\bclientId\x12E\n" +
+	"\vsource_type\x18\t \x01(\x0e2$.sgnl.adapter.v1.ConnectorSourceTypeR\n" +
+	"sourceType\x12\x1b\n" +
+	"\tsource_id\x18\n" +
+	" \x01(\tR\bsourceId\"\xe9\x01\n" +
 	"\x19DatasourceAuthCredentials\x12H\n" +
 	"\x05basic\x18\x01 \x01(\v20.sgnl.adapter.v1.DatasourceAuthCredentials.BasicH\x00R\x05basic\x12/\n" +
 	"\x12http_authorization\x18\x02 \x01(\tH\x00R\x11httpAuthorization\x1a?\n" +
@@ -1489,22 +2310,30 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"externalId\x122\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x1e.sgnl.adapter.v1.AttributeTypeR\x04type\x12\x12\n" +
 	"\x04list\x18\x04 \x01(\bR\x04list\x12\x1b\n" +
-	"\tunique_id\x18\x05 \x01(\bR\buniqueId\"Z\n" +
+	"\tunique_id\x18\x05 \x01(\bR\buniqueId\"\x90\x01\n" +
 	"\x04Page\x121\n" +
 	"\aobjects\x18\x01 \x03(\v2\x17.sgnl.adapter.v1.ObjectR\aobjects\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
-	"nextCursor\"\x89\x01\n" +
+	"nextCursor\x124\n" +
+	"\bwarnings\x18\x03 \x03(\v2\x18.sgnl.adapter.v1.WarningR\bwarnings\"\xa6\x01\n" +
+	"\aWarning\x120\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x1c.sgnl.adapter.v1.WarningCodeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tobject_id\x18\x03 \x01(\tR\bobjectId\x122\n" +
+	"\x15attribute_external_id\x18\x04 \x01(\tR\x13attributeExternalId\"\x89\x01\n" +
 	"\x06Object\x12:\n" +
 	"\n" +
 	"attributes\x18\x01 \x03(\v2\x1a.sgnl.adapter.v1.AttributeR\n" +
 	"attributes\x12C\n" +
-	"\rchild_objects\x18\x02 \x03(\v2\x1e.sgnl.adapter.v1.EntityObjectsR\fchildObjects\"_\n" +
+	"\rchild_objects\x18\x02 \x03(\v2\x1e.sgnl.adapter.v1.EntityObjectsR\fchildObjects\"\x80\x01\n" +
 	"\rEntityObjects\x12\x1b\n" +
 	"\tentity_id\x18\x01 \x01(\tR\bentityId\x121\n" +
-	"\aobjects\x18\x02 \x03(\v2\x17.sgnl.adapter.v1.ObjectR\aobjects\"T\n" +
+	"\aobjects\x18\x02 \x03(\v2\x17.sgnl.adapter.v1.ObjectR\aobjects\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"T\n" +
 	"\tAttribute\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
-	"\x06values\x18\x02 \x03(\v2\x1f.sgnl.adapter.v1.AttributeValueR\x06values\"\xe8\x02\n" +
+	"\x06values\x18\x02 \x03(\v2\x1f.sgnl.adapter.v1.AttributeValueR\x06values\"\xac\x03\n" +
 	"\x0eAttributeValue\x127\n" +
 	"\n" +
 	"null_value\x18\x01 \x01(\v2\x16.google.protobuf.EmptyH\x00R\tnullValue\x12\x1f\n" +
@@ -1515,7 +2344,11 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\x0eduration_value\x18\x05 \x01(\v2\x19.sgnl.adapter.v1.DurationH\x00R\rdurationValue\x12!\n" +
 	"\vint64_value\x18\x06 \x01(\x03H\x00R\n" +
 	"int64Value\x12#\n" +
-	"\fstring_value\x18\a \x01(\tH\x00R\vstringValueB\a\n" +
+	"\fstring_value\x18\a \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vbytes_value\x18\b \x01(\fH\x00R\n" +
+	"bytesValue\x12\x1f\n" +
+	"\n" +
+	"uuid_value\x18\t \x01(\tH\x00R\tuuidValueB\a\n" +
 	"\x05value\"f\n" +
 	"\bDuration\x12\x18\n" +
 	"\aseconds\x18\x01 \x01(\x03R\aseconds\x12\x14\n" +
@@ -1524,12 +2357,24 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\x04days\x18\x04 \x01(\x03R\x04days\"m\n" +
 	"\bDateTime\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12'\n" +
-	"\x0ftimezone_offset\x18\x02 \x01(\x05R\x0etimezoneOffset\"\x8d\x01\n" +
+	"\x0ftimezone_offset\x18\x02 \x01(\x05R\x0etimezoneOffset\"\xb7\x03\n" +
 	"\x05Error\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12.\n" +
 	"\x04code\x18\x02 \x01(\x0e2\x1a.sgnl.adapter.v1.ErrorCodeR\x04code\x12:\n" +
 	"\vretry_after\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
-	"retryAfter*\xd3\x01\n" +
+	"retryAfter\x120\n" +
+	"\x14upstream_status_code\x18\x04 \x01(\x05R\x12upstreamStatusCode\x12.\n" +
+	"\x13upstream_request_id\x18\x05 \x01(\tR\x11upstreamRequestId\x122\n" +
+	"\x15attribute_external_id\x18\x06 \x01(\tR\x13attributeExternalId\x12,\n" +
+	"\x12entity_external_id\x18\a \x01(\tR\x10entityExternalId\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1c\n" +
+	"\ttransient\x18\t \x01(\bR\ttransient\x12.\n" +
+	"\x13suggested_page_size\x18\n" +
+	" \x01(\x03R\x11suggestedPageSize*\x89\x01\n" +
+	"\x13ConnectorSourceType\x12%\n" +
+	"!CONNECTOR_SOURCE_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" CONNECTOR_SOURCE_TYPE_DATASOURCE\x10\x01\x12%\n" +
+	"!CONNECTOR_SOURCE_TYPE_INTEGRATION\x10\x02*\x86\x02\n" +
 	"\rAttributeType\x12\x1e\n" +
 	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13ATTRIBUTE_TYPE_BOOL\x10\x01\x12\x1c\n" +
@@ -1537,7 +2382,15 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\x15ATTRIBUTE_TYPE_DOUBLE\x10\x03\x12\x1b\n" +
 	"\x17ATTRIBUTE_TYPE_DURATION\x10\x04\x12\x18\n" +
 	"\x14ATTRIBUTE_TYPE_INT64\x10\x05\x12\x19\n" +
-	"\x15ATTRIBUTE_TYPE_STRING\x10\x06*\x93\x04\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x06\x12\x18\n" +
+	"\x14ATTRIBUTE_TYPE_BYTES\x10\a\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_UUID\x10\b*\xb7\x01\n" +
+	"\vWarningCode\x12\x1c\n" +
+	"\x18WARNING_CODE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bWARNING_CODE_OBJECT_SKIPPED\x10\x01\x12\"\n" +
+	"\x1eWARNING_CODE_ATTRIBUTE_SKIPPED\x10\x02\x12$\n" +
+	" WARNING_CODE_ATTRIBUTE_TRUNCATED\x10\x03\x12\x1f\n" +
+	"\x1bWARNING_CODE_DEPRECATED_API\x10\x04*\x82\x05\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12*\n" +
 	"&ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG\x10\x01\x12(\n" +
@@ -1552,9 +2405,14 @@ const file_api_adapter_v1_adapter_proto_rawDesc = "" +
 	"\x1cERROR_CODE_DATASOURCE_FAILED\x10\n" +
 	"\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\v\x12+\n" +
-	"'ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS\x10\f2Y\n" +
+	"'ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS\x10\f\x12\x1d\n" +
+	"\x19ERROR_CODE_CURSOR_EXPIRED\x10\r\x12\x1f\n" +
+	"\x1bERROR_CODE_ENTITY_NOT_FOUND\x10\x0e\x12-\n" +
+	")ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS\x10\x0f2\xa6\x02\n" +
 	"\aAdapter\x12N\n" +
-	"\aGetPage\x12\x1f.sgnl.adapter.v1.GetPageRequest\x1a .sgnl.adapter.v1.GetPageResponse\"\x00B5Z3github.com/sgnl-ai/adapter-framework/api/adapter/v1b\x06proto3"
+	"\aGetPage\x12\x1f.sgnl.adapter.v1.GetPageRequest\x1a .sgnl.adapter.v1.GetPageResponse\"\x00\x12f\n" +
+	"\x0fGetCapabilities\x12'.sgnl.adapter.v1.GetCapabilitiesRequest\x1a(.sgnl.adapter.v1.GetCapabilitiesResponse\"\x00\x12c\n" +
+	"\x0eValidateConfig\x12&.sgnl.adapter.v1.ValidateConfigRequest\x1a'.sgnl.adapter.v1.ValidateConfigResponse\"\x00B5Z3github.com/sgnl-ai/adapter-framework/api/adapter/v1b\x06proto3"
 
 var (
 	file_api_adapter_v1_adapter_proto_rawDescOnce sync.Once
@@ -1568,60 +2426,82 @@ func file_api_adapter_v1_adapter_proto_rawDescGZIP() []byte {
 	return file_api_adapter_v1_adapter_proto_rawDescData
 }
 
-var file_api_adapter_v1_adapter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_adapter_v1_adapter_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_adapter_v1_adapter_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_adapter_v1_adapter_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_adapter_v1_adapter_proto_goTypes = []any{
-	(AttributeType)(0),                      // 0: sgnl.adapter.v1.AttributeType
-	(ErrorCode)(0),                          // 1: sgnl.adapter.v1.ErrorCode
-	(*GetPageRequest)(nil),                  // 2: sgnl.adapter.v1.GetPageRequest
-	(*GetPageResponse)(nil),                 // 3: sgnl.adapter.v1.GetPageResponse
-	(*DatasourceConfig)(nil),                // 4: sgnl.adapter.v1.DatasourceConfig
-	(*ConnectorInfo)(nil),                   // 5: sgnl.adapter.v1.ConnectorInfo
-	(*DatasourceAuthCredentials)(nil),       // 6: sgnl.adapter.v1.DatasourceAuthCredentials
-	(*EntityConfig)(nil),                    // 7: sgnl.adapter.v1.EntityConfig
-	(*AttributeConfig)(nil),                 // 8: sgnl.adapter.v1.AttributeConfig
-	(*Page)(nil),                            // 9: sgnl.adapter.v1.Page
-	(*Object)(nil),                          // 10: sgnl.adapter.v1.Object
-	(*EntityObjects)(nil),                   // 11: sgnl.adapter.v1.EntityObjects
-	(*Attribute)(nil),                       // 12: sgnl.adapter.v1.Attribute
-	(*AttributeValue)(nil),                  // 13: sgnl.adapter.v1.AttributeValue
-	(*Duration)(nil),                        // 14: sgnl.adapter.v1.Duration
-	(*DateTime)(nil),                        // 15: sgnl.adapter.v1.DateTime
-	(*Error)(nil),                           // 16: sgnl.adapter.v1.Error
-	(*DatasourceAuthCredentials_Basic)(nil), // 17: sgnl.adapter.v1.DatasourceAuthCredentials.Basic
-	(*emptypb.Empty)(nil),                   // 18: google.protobuf.Empty
-	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),             // 20: google.protobuf.Duration
+	(ConnectorSourceType)(0),                // 0: sgnl.adapter.v1.ConnectorSourceType
+	(AttributeType)(0),                      // 1: sgnl.adapter.v1.AttributeType
+	(WarningCode)(0),                        // 2: sgnl.adapter.v1.WarningCode
+	(ErrorCode)(0),                          // 3: sgnl.adapter.v1.ErrorCode
+	(*GetPageRequest)(nil),                  // 4: sgnl.adapter.v1.GetPageRequest
+	(*ChildPageRequest)(nil),                // 5: sgnl.adapter.v1.ChildPageRequest
+	(*GetPageResponse)(nil),                 // 6: sgnl.adapter.v1.GetPageResponse
+	(*GetCapabilitiesRequest)(nil),          // 7: sgnl.adapter.v1.GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil),         // 8: sgnl.adapter.v1.GetCapabilitiesResponse
+	(*DatasourceCapabilities)(nil),          // 9: sgnl.adapter.v1.DatasourceCapabilities
+	(*EntityCapabilities)(nil),              // 10: sgnl.adapter.v1.EntityCapabilities
+	(*ValidateConfigRequest)(nil),           // 11: sgnl.adapter.v1.ValidateConfigRequest
+	(*ValidateConfigResponse)(nil),          // 12: sgnl.adapter.v1.ValidateConfigResponse
+	(*DatasourceConfig)(nil),                // 13: sgnl.adapter.v1.DatasourceConfig
+	(*ConnectorInfo)(nil),                   // 14: sgnl.adapter.v1.ConnectorInfo
+	(*DatasourceAuthCredentials)(nil),       // 15: sgnl.adapter.v1.DatasourceAuthCredentials
+	(*EntityConfig)(nil),                    // 16: sgnl.adapter.v1.EntityConfig
+	(*AttributeConfig)(nil),                 // 17: sgnl.adapter.v1.AttributeConfig
+	(*Page)(nil),                            // 18: sgnl.adapter.v1.Page
+	(*Warning)(nil),                         // 19: sgnl.adapter.v1.Warning
+	(*Object)(nil),                          // 20: sgnl.adapter.v1.Object
+	(*EntityObjects)(nil),                   // 21: sgnl.adapter.v1.EntityObjects
+	(*Attribute)(nil),                       // 22: sgnl.adapter.v1.Attribute
+	(*AttributeValue)(nil),                  // 23: sgnl.adapter.v1.AttributeValue
+	(*Duration)(nil),                        // 24: sgnl.adapter.v1.Duration
+	(*DateTime)(nil),                        // 25: sgnl.adapter.v1.DateTime
+	(*Error)(nil),                           // 26: sgnl.adapter.v1.Error
+	(*DatasourceAuthCredentials_Basic)(nil), // 27: sgnl.adapter.v1.DatasourceAuthCredentials.Basic
+	(*emptypb.Empty)(nil),                   // 28: google.protobuf.Empty
+	(*timestamppb.Timestamp)(nil),           // 29: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 30: google.protobuf.Duration
 }
 var file_api_adapter_v1_adapter_proto_depIdxs = []int32{
-	4,  // 0: sgnl.adapter.v1.GetPageRequest.datasource:type_name -> sgnl.adapter.v1.DatasourceConfig
-	7,  // 1: sgnl.adapter.v1.GetPageRequest.entity:type_name -> sgnl.adapter.v1.EntityConfig
-	9,  // 2: sgnl.adapter.v1.GetPageResponse.success:type_name -> sgnl.adapter.v1.Page
-	16, // 3: sgnl.adapter.v1.GetPageResponse.error:type_name -> sgnl.adapter.v1.Error
-	6,  // 4: sgnl.adapter.v1.DatasourceConfig.auth:type_name -> sgnl.adapter.v1.DatasourceAuthCredentials
-	5,  // 5: sgnl.adapter.v1.DatasourceConfig.connector_info:type_name -> sgnl.adapter.v1.ConnectorInfo
-	17, // 6: sgnl.adapter.v1.DatasourceAuthCredentials.basic:type_name -> sgnl.adapter.v1.DatasourceAuthCredentials.Basic
-	8,  // 7: sgnl.adapter.v1.EntityConfig.attributes:type_name -> sgnl.adapter.v1.AttributeConfig
-	7,  // 8: sgnl.adapter.v1.EntityConfig.child_entities:type_name -> sgnl.adapter.v1.EntityConfig
-	0,  // 9: sgnl.adapter.v1.AttributeConfig.type:type_name -> sgnl.adapter.v1.AttributeType
-	10, // 10: sgnl.adapter.v1.Page.objects:type_name -> sgnl.adapter.v1.Object
-	12, // 11: sgnl.adapter.v1.Object.attributes:type_name -> sgnl.adapter.v1.Attribute
-	11, // 12: sgnl.adapter.v1.Object.child_objects:type_name -> sgnl.adapter.v1.EntityObjects
-	10, // 13: sgnl.adapter.v1.EntityObjects.objects:type_name -> sgnl.adapter.v1.Object
-	13, // 14: sgnl.adapter.v1.Attribute.values:type_name -> sgnl.adapter.v1.AttributeValue
-	18, // 15: sgnl.adapter.v1.AttributeValue.null_value:type_name -> google.protobuf.Empty
-	15, // 16: sgnl.adapter.v1.AttributeValue.datetime_value:type_name -> sgnl.adapter.v1.DateTime
-	14, // 17: sgnl.adapter.v1.AttributeValue.duration_value:type_name -> sgnl.adapter.v1.Duration
-	19, // 18: sgnl.adapter.v1.DateTime.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 19: sgnl.adapter.v1.Error.code:type_name -> sgnl.adapter.v1.ErrorCode
-	20, // 20: sgnl.adapter.v1.Error.retry_after:type_name -> google.protobuf.Duration
-	2,  // 21: sgnl.adapter.v1.Adapter.GetPage:input_type -> sgnl.adapter.v1.GetPageRequest
-	3,  // 22: sgnl.adapter.v1.Adapter.GetPage:output_type -> sgnl.adapter.v1.GetPageResponse
-	22, // [22:23] is the sub-list for method output_type
-	21, // [21:22] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	13, // 0: sgnl.adapter.v1.GetPageRequest.datasource:type_name -> sgnl.adapter.v1.DatasourceConfig
+	16, // 1: sgnl.adapter.v1.GetPageRequest.entity:type_name -> sgnl.adapter.v1.EntityConfig
+	5,  // 2: sgnl.adapter.v1.GetPageRequest.child_page:type_name -> sgnl.adapter.v1.ChildPageRequest
+	18, // 3: sgnl.adapter.v1.GetPageResponse.success:type_name -> sgnl.adapter.v1.Page
+	26, // 4: sgnl.adapter.v1.GetPageResponse.error:type_name -> sgnl.adapter.v1.Error
+	9,  // 5: sgnl.adapter.v1.GetCapabilitiesResponse.datasources:type_name -> sgnl.adapter.v1.DatasourceCapabilities
+	10, // 6: sgnl.adapter.v1.DatasourceCapabilities.entities:type_name -> sgnl.adapter.v1.EntityCapabilities
+	13, // 7: sgnl.adapter.v1.ValidateConfigRequest.datasource:type_name -> sgnl.adapter.v1.DatasourceConfig
+	26, // 8: sgnl.adapter.v1.ValidateConfigResponse.error:type_name -> sgnl.adapter.v1.Error
+	15, // 9: sgnl.adapter.v1.DatasourceConfig.auth:type_name -> sgnl.adapter.v1.DatasourceAuthCredentials
+	14, // 10: sgnl.adapter.v1.DatasourceConfig.connector_info:type_name -> sgnl.adapter.v1.ConnectorInfo
+	0,  // 11: sgnl.adapter.v1.ConnectorInfo.source_type:type_name -> sgnl.adapter.v1.ConnectorSourceType
+	27, // 12: sgnl.adapter.v1.DatasourceAuthCredentials.basic:type_name -> sgnl.adapter.v1.DatasourceAuthCredentials.Basic
+	17, // 13: sgnl.adapter.v1.EntityConfig.attributes:type_name -> sgnl.adapter.v1.AttributeConfig
+	16, // 14: sgnl.adapter.v1.EntityConfig.child_entities:type_name -> sgnl.adapter.v1.EntityConfig
+	1,  // 15: sgnl.adapter.v1.AttributeConfig.type:type_name -> sgnl.adapter.v1.AttributeType
+	20, // 16: sgnl.adapter.v1.Page.objects:type_name -> sgnl.adapter.v1.Object
+	19, // 17: sgnl.adapter.v1.Page.warnings:type_name -> sgnl.adapter.v1.Warning
+	2,  // 18: sgnl.adapter.v1.Warning.code:type_name -> sgnl.adapter.v1.WarningCode
+	22, // 19: sgnl.adapter.v1.Object.attributes:type_name -> sgnl.adapter.v1.Attribute
+	21, // 20: sgnl.adapter.v1.Object.child_objects:type_name -> sgnl.adapter.v1.EntityObjects
+	20, // 21: sgnl.adapter.v1.EntityObjects.objects:type_name -> sgnl.adapter.v1.Object
+	23, // 22: sgnl.adapter.v1.Attribute.values:type_name -> sgnl.adapter.v1.AttributeValue
+	28, // 23: sgnl.adapter.v1.AttributeValue.null_value:type_name -> google.protobuf.Empty
+	25, // 24: sgnl.adapter.v1.AttributeValue.datetime_value:type_name -> sgnl.adapter.v1.DateTime
+	24, // 25: sgnl.adapter.v1.AttributeValue.duration_value:type_name -> sgnl.adapter.v1.Duration
+	29, // 26: sgnl.adapter.v1.DateTime.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 27: sgnl.adapter.v1.Error.code:type_name -> sgnl.adapter.v1.ErrorCode
+	30, // 28: sgnl.adapter.v1.Error.retry_after:type_name -> google.protobuf.Duration
+	4,  // 29: sgnl.adapter.v1.Adapter.GetPage:input_type -> sgnl.adapter.v1.GetPageRequest
+	7,  // 30: sgnl.adapter.v1.Adapter.GetCapabilities:input_type -> sgnl.adapter.v1.GetCapabilitiesRequest
+	11, // 31: sgnl.adapter.v1.Adapter.ValidateConfig:input_type -> sgnl.adapter.v1.ValidateConfigRequest
+	6,  // 32: sgnl.adapter.v1.Adapter.GetPage:output_type -> sgnl.adapter.v1.GetPageResponse
+	8,  // 33: sgnl.adapter.v1.Adapter.GetCapabilities:output_type -> sgnl.adapter.v1.GetCapabilitiesResponse
+	12, // 34: sgnl.adapter.v1.Adapter.ValidateConfig:output_type -> sgnl.adapter.v1.ValidateConfigResponse
+	32, // [32:35] is the sub-list for method output_type
+	29, // [29:32] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_api_adapter_v1_adapter_proto_init() }
@@ -1629,15 +2509,15 @@ func file_api_adapter_v1_adapter_proto_init() {
 	if File_api_adapter_v1_adapter_proto != nil {
 		return
 	}
-	file_api_adapter_v1_adapter_proto_msgTypes[1].OneofWrappers = []any{
+	file_api_adapter_v1_adapter_proto_msgTypes[2].OneofWrappers = []any{
 		(*GetPageResponse_Success)(nil),
 		(*GetPageResponse_Error)(nil),
 	}
-	file_api_adapter_v1_adapter_proto_msgTypes[4].OneofWrappers = []any{
+	file_api_adapter_v1_adapter_proto_msgTypes[11].OneofWrappers = []any{
 		(*DatasourceAuthCredentials_Basic_)(nil),
 		(*DatasourceAuthCredentials_HttpAuthorization)(nil),
 	}
-	file_api_adapter_v1_adapter_proto_msgTypes[11].OneofWrappers = []any{
+	file_api_adapter_v1_adapter_proto_msgTypes[19].OneofWrappers = []any{
 		(*AttributeValue_NullValue)(nil),
 		(*AttributeValue_BoolValue)(nil),
 		(*AttributeValue_DatetimeValue)(nil),
@@ -1645,14 +2525,16 @@ func file_api_adapter_v1_adapter_proto_init() {
 		(*AttributeValue_DurationValue)(nil),
 		(*AttributeValue_Int64Value)(nil),
 		(*AttributeValue_StringValue)(nil),
+		(*AttributeValue_BytesValue)(nil),
+		(*AttributeValue_UuidValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_adapter_v1_adapter_proto_rawDesc), len(file_api_adapter_v1_adapter_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      4,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
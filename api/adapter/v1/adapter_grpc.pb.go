@@ -33,7 +33,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Adapter_GetPage_FullMethodName = "/sgnl.adapter.v1.Adapter/GetPage"
+	Adapter_GetPage_FullMethodName         = "/sgnl.adapter.v1.Adapter/GetPage"
+	Adapter_GetCapabilities_FullMethodName = "/sgnl.adapter.v1.Adapter/GetCapabilities"
+	Adapter_ValidateConfig_FullMethodName  = "/sgnl.adapter.v1.Adapter/ValidateConfig"
 )
 
 // AdapterClient is the client API for Adapter service.
@@ -44,6 +46,13 @@ const (
 type AdapterClient interface {
 	// Pulls the next page of objects from a datasource for an entity and its child entities.
 	GetPage(ctx context.Context, in *GetPageRequest, opts ...grpc.CallOption) (*GetPageResponse, error)
+	// Returns the capabilities of the adapter for each datasource type it
+	// supports, e.g. the JSON Schema of the datasource configs.
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	// Validates a datasource config, and returns it migrated to the latest
+	// config version supported by the adapter, so that stored configs can be
+	// upgraded.
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
 }
 
 type adapterClient struct {
@@ -64,6 +73,26 @@ func (c *adapterClient) GetPage(ctx context.Context, in *GetPageRequest, opts ..
 	return out, nil
 }
 
+func (c *adapterClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Adapter_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adapterClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigResponse)
+	err := c.cc.Invoke(ctx, Adapter_ValidateConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdapterServer is the server API for Adapter service.
 // All implementations must embed UnimplementedAdapterServer
 // for forward compatibility.
@@ -72,6 +101,13 @@ func (c *adapterClient) GetPage(ctx context.Context, in *GetPageRequest, opts ..
 type AdapterServer interface {
 	// Pulls the next page of objects from a datasource for an entity and its child entities.
 	GetPage(context.Context, *GetPageRequest) (*GetPageResponse, error)
+	// Returns the capabilities of the adapter for each datasource type it
+	// supports, e.g. the JSON Schema of the datasource configs.
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
+	// Validates a datasource config, and returns it migrated to the latest
+	// config version supported by the adapter, so that stored configs can be
+	// upgraded.
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
 	mustEmbedUnimplementedAdapterServer()
 }
 
@@ -85,6 +121,12 @@ type UnimplementedAdapterServer struct{}
 func (UnimplementedAdapterServer) GetPage(context.Context, *GetPageRequest) (*GetPageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPage not implemented")
 }
+func (UnimplementedAdapterServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedAdapterServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
 func (UnimplementedAdapterServer) mustEmbedUnimplementedAdapterServer() {}
 func (UnimplementedAdapterServer) testEmbeddedByValue()                 {}
 
@@ -124,6 +166,42 @@ func _Adapter_GetPage_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Adapter_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdapterServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Adapter_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdapterServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Adapter_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdapterServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Adapter_ValidateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdapterServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Adapter_ServiceDesc is the grpc.ServiceDesc for Adapter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +213,14 @@ var Adapter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPage",
 			Handler:    _Adapter_GetPage_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Adapter_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "ValidateConfig",
+			Handler:    _Adapter_ValidateConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/adapter/v1/adapter.proto",
@@ -0,0 +1,175 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// softDeadlineKey is the key for storing the softDeadline in a derived context.
+type softDeadlineKey struct{}
+
+// softDeadline is the soft deadline of a GetPage request.
+type softDeadline struct {
+	// deadline is the time after which adapters should stop collecting
+	// objects and return the objects collected so far.
+	deadline time.Time
+
+	// partial is set once a PageAccumulator returned a page that was cut
+	// short because the soft deadline passed.
+	partial atomic.Bool
+}
+
+// WithSoftDeadline returns a derived context carrying a soft deadline that
+// precedes the deadline of ctx by the given safety margin.
+//
+// If the margin is larger than half of the time remaining until the deadline
+// of ctx, the soft deadline is set halfway to the deadline instead, so that
+// adapters always get a chance to collect objects.
+// If ctx has no deadline, the returned context has no soft deadline either.
+func WithSoftDeadline(ctx context.Context, margin time.Duration) context.Context {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return ctx
+	}
+
+	if remaining := time.Until(deadline); margin > remaining/2 {
+		margin = remaining / 2
+	}
+
+	if margin < 0 {
+		margin = 0
+	}
+
+	return context.WithValue(ctx, softDeadlineKey{}, &softDeadline{
+		deadline: deadline.Add(-margin),
+	})
+}
+
+// SoftDeadline returns the time after which the adapter should stop collecting
+// objects and return the objects it has collected so far.
+// If ctx carries no soft deadline, the deadline of ctx is returned.
+// ok is false if ctx has no deadline at all.
+func SoftDeadline(ctx context.Context) (deadline time.Time, ok bool) {
+	if sd, found := ctx.Value(softDeadlineKey{}).(*softDeadline); found {
+		return sd.deadline, true
+	}
+
+	return ctx.Deadline()
+}
+
+// SoftDeadlineExceeded returns true if the soft deadline of ctx has passed.
+func SoftDeadlineExceeded(ctx context.Context) bool {
+	deadline, ok := SoftDeadline(ctx)
+
+	return ok && !time.Now().Before(deadline)
+}
+
+// IsPartialPage returns true if a PageAccumulator created from ctx returned
+// a page that was cut short because the soft deadline passed.
+func IsPartialPage(ctx context.Context) bool {
+	sd, found := ctx.Value(softDeadlineKey{}).(*softDeadline)
+
+	return found && sd.partial.Load()
+}
+
+// PageAccumulator collects the objects of a page until either the page is
+// full or the soft deadline of the request has passed.
+//
+// A typical adapter loops over datasource requests until Done returns true,
+// then returns the result of Page with a cursor that resumes right after the
+// last collected object, e.g. with offset cursors:
+//
+//	acc := framework.NewPageAccumulator(ctx, request.PageSize)
+//	offset := parseOffset(request.Cursor)
+//	for !acc.Done() {
+//		objects, more, err := fetch(ctx, offset)
+//		...
+//		n := acc.Add(objects...)
+//		offset += n
+//		if !more && n == len(objects) {
+//			// All the objects were collected.
+//			return framework.NewGetPageResponseSuccess(acc.Page(""))
+//		}
+//	}
+//	return framework.NewGetPageResponseSuccess(acc.Page(formatOffset(offset)))
+type PageAccumulator struct {
+	ctx      context.Context
+	pageSize int64
+	objects  []Object
+}
+
+// NewPageAccumulator returns a PageAccumulator for a page of up to pageSize
+// objects, which stops collecting objects once the soft deadline of ctx has
+// passed.
+func NewPageAccumulator(ctx context.Context, pageSize int64) *PageAccumulator {
+	return &PageAccumulator{
+		ctx:      ctx,
+		pageSize: pageSize,
+	}
+}
+
+// Add appends the given objects to the page, up to the remaining capacity of
+// the page, and returns the number of objects appended. The objects beyond
+// that capacity are not appended, and the cursor of the page must identify the
+// first of them.
+func (a *PageAccumulator) Add(objects ...Object) int {
+	if a.pageSize > 0 {
+		objects = objects[:min(int64(len(objects)), max(a.pageSize-int64(len(a.objects)), 0))]
+	}
+
+	a.objects = append(a.objects, objects...)
+
+	return len(objects)
+}
+
+// Len returns the number of objects collected so far.
+func (a *PageAccumulator) Len() int {
+	return len(a.objects)
+}
+
+// Full returns true if the page contains at least pageSize objects.
+func (a *PageAccumulator) Full() bool {
+	return a.pageSize > 0 && int64(len(a.objects)) >= a.pageSize
+}
+
+// Done returns true if the adapter should stop collecting objects, i.e. if the
+// page is full, or if the soft deadline has passed and at least one object has
+// been collected.
+// As long as no object has been collected, Done ignores the soft deadline so
+// that every page makes progress.
+func (a *PageAccumulator) Done() bool {
+	return a.Full() || (len(a.objects) > 0 && SoftDeadlineExceeded(a.ctx))
+}
+
+// Page returns a page containing the collected objects and the given cursor.
+// nextCursor must identify the object following the last collected object.
+//
+// If the page is not full and the soft deadline has passed, the page is
+// recorded as partial so that the server can report it.
+func (a *PageAccumulator) Page(nextCursor string) *Page {
+	if !a.Full() && nextCursor != "" && SoftDeadlineExceeded(a.ctx) {
+		if sd, found := a.ctx.Value(softDeadlineKey{}).(*softDeadline); found {
+			sd.partial.Store(true)
+		}
+	}
+
+	return &Page{
+		Objects:    a.objects,
+		NextCursor: nextCursor,
+	}
+}
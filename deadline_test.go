@@ -0,0 +1,169 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWithSoftDeadline(t *testing.T) {
+	if _, ok := SoftDeadline(WithSoftDeadline(context.Background(), time.Second)); ok {
+		t.Error("Expected no soft deadline for a context without deadline")
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	gotDeadline, ok := SoftDeadline(ctx)
+	if !ok || !gotDeadline.Equal(deadline) {
+		t.Errorf("Expected soft deadline %v to default to the context deadline, got %v", deadline, gotDeadline)
+	}
+
+	gotDeadline, ok = SoftDeadline(WithSoftDeadline(ctx, 2*time.Second))
+	if want := deadline.Add(-2 * time.Second); !ok || !gotDeadline.Equal(want) {
+		t.Errorf("Expected soft deadline %v, got %v", want, gotDeadline)
+	}
+
+	// The margin is capped to half of the remaining time.
+	gotDeadline, ok = SoftDeadline(WithSoftDeadline(ctx, time.Minute))
+	if !ok || !gotDeadline.After(time.Now()) || !gotDeadline.Before(deadline) {
+		t.Errorf("Expected soft deadline between now and %v, got %v", deadline, gotDeadline)
+	}
+}
+
+func TestPageAccumulator_Full(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ctx = WithSoftDeadline(ctx, time.Second)
+
+	acc := NewPageAccumulator(ctx, 3)
+
+	acc.Add(Object{"id": "1"}, Object{"id": "2"})
+
+	if acc.Done() {
+		t.Error("Expected accumulator not to be done")
+	}
+
+	acc.Add(Object{"id": "3"})
+
+	if !acc.Done() || !acc.Full() {
+		t.Error("Expected accumulator to be full")
+	}
+
+	wantPage := &Page{
+		Objects:    []Object{{"id": "1"}, {"id": "2"}, {"id": "3"}},
+		NextCursor: "3",
+	}
+
+	if gotPage := acc.Page("3"); !reflect.DeepEqual(wantPage, gotPage) {
+		t.Errorf("Expected %#v, got %#v", wantPage, gotPage)
+	}
+
+	if IsPartialPage(ctx) {
+		t.Error("Expected a full page not to be partial")
+	}
+}
+
+func TestPageAccumulator_AddBeyondPageSize(t *testing.T) {
+	tests := map[string]struct {
+		pageSize    int64
+		batches     [][]Object
+		wantAdded   []int
+		wantObjects []Object
+	}{
+		"batch_crossing_the_limit": {
+			pageSize:    3,
+			batches:     [][]Object{{{"id": "1"}, {"id": "2"}}, {{"id": "3"}, {"id": "4"}}},
+			wantAdded:   []int{2, 1},
+			wantObjects: []Object{{"id": "1"}, {"id": "2"}, {"id": "3"}},
+		},
+		"batch_after_full": {
+			pageSize:    1,
+			batches:     [][]Object{{{"id": "1"}}, {{"id": "2"}}},
+			wantAdded:   []int{1, 0},
+			wantObjects: []Object{{"id": "1"}},
+		},
+		"no_page_size": {
+			pageSize:    0,
+			batches:     [][]Object{{{"id": "1"}, {"id": "2"}}},
+			wantAdded:   []int{2},
+			wantObjects: []Object{{"id": "1"}, {"id": "2"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			acc := NewPageAccumulator(context.Background(), tc.pageSize)
+
+			var gotAdded []int
+
+			for _, batch := range tc.batches {
+				gotAdded = append(gotAdded, acc.Add(batch...))
+			}
+
+			if !reflect.DeepEqual(tc.wantAdded, gotAdded) {
+				t.Errorf("Expected added counts %v, got %v", tc.wantAdded, gotAdded)
+			}
+
+			if gotPage := acc.Page(""); !reflect.DeepEqual(tc.wantObjects, gotPage.Objects) {
+				t.Errorf("Expected objects %v, got %v", tc.wantObjects, gotPage.Objects)
+			}
+		})
+	}
+}
+
+func TestPageAccumulator_SoftDeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	ctx = WithSoftDeadline(ctx, 50*time.Millisecond)
+
+	acc := NewPageAccumulator(ctx, 100)
+
+	time.Sleep(60 * time.Millisecond)
+
+	if !SoftDeadlineExceeded(ctx) {
+		t.Fatal("Expected soft deadline to be exceeded")
+	}
+
+	if acc.Done() {
+		t.Error("Expected accumulator with no objects to ignore the soft deadline")
+	}
+
+	acc.Add(Object{"id": "1"})
+
+	if !acc.Done() {
+		t.Error("Expected accumulator to be done after the soft deadline")
+	}
+
+	wantPage := &Page{
+		Objects:    []Object{{"id": "1"}},
+		NextCursor: "1",
+	}
+
+	if gotPage := acc.Page("1"); !reflect.DeepEqual(wantPage, gotPage) {
+		t.Errorf("Expected %#v, got %#v", wantPage, gotPage)
+	}
+
+	if !IsPartialPage(ctx) {
+		t.Error("Expected page to be partial")
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DaysPerMonth is the number of days in a month, used to convert between
+	// months and days in durations, which have no calendar context.
+	DaysPerMonth = 30
+
+	// SecondsPerDay is the number of seconds in a day, used to convert between
+	// days and seconds in durations, which have no calendar context.
+	SecondsPerDay = 24 * 3_600
+
+	nanosPerSecond = 1_000_000_000
+)
+
+// Neg returns the opposite of the duration.
+func (d Duration) Neg() Duration {
+	return Duration{
+		Months:  -d.Months,
+		Days:    -d.Days,
+		Seconds: -d.Seconds,
+		Nanos:   -d.Nanos,
+	}
+}
+
+// ISO8601 returns the duration formatted as an ISO8601 duration string, e.g.
+// "P1M2DT3H4M5.5S", or "-PT1H" for a negative duration.
+// Seconds are expressed as hours, minutes and seconds.
+// A zero duration is formatted as "PT0S".
+//
+// Durations mixing positive and negative fields have a sign on each negative
+// component, e.g. "P1M-2D", which is not standard ISO8601 and cannot be parsed
+// by ParseISO8601Duration.
+func (d Duration) ISO8601() string {
+	if d == (Duration{}) {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+
+	if d.Months <= 0 && d.Days <= 0 && d.Seconds <= 0 && d.Nanos <= 0 {
+		b.WriteByte('-')
+		d = d.Neg()
+	}
+
+	b.WriteByte('P')
+
+	if d.Months != 0 {
+		fmt.Fprintf(&b, "%dM", d.Months)
+	}
+
+	if d.Days != 0 {
+		fmt.Fprintf(&b, "%dD", d.Days)
+	}
+
+	seconds, nanos := normalizeSecondsAndNanos(d.Seconds, int64(d.Nanos))
+	if seconds == 0 && nanos == 0 {
+		return b.String()
+	}
+
+	b.WriteByte('T')
+
+	if hours := seconds / 3_600; hours != 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+		seconds %= 3_600
+	}
+
+	if minutes := seconds / 60; minutes != 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+		seconds %= 60
+	}
+
+	switch {
+	case nanos != 0:
+		if seconds == 0 && nanos < 0 {
+			b.WriteByte('-')
+		}
+
+		fraction := strings.TrimRight(fmt.Sprintf("%09d", abs(nanos)), "0")
+		fmt.Fprintf(&b, "%d.%sS", seconds, fraction)
+	case seconds != 0:
+		fmt.Fprintf(&b, "%dS", seconds)
+	}
+
+	return b.String()
+}
+
+// ToTimeDuration converts the duration into a time.Duration, converting months
+// into DaysPerMonth days and days into SecondsPerDay seconds.
+// Returns an error if the duration overflows a time.Duration, i.e. if it is
+// longer than about 292 years.
+func (d Duration) ToTimeDuration() (time.Duration, error) {
+	total := big.NewInt(d.Months)
+	total.Mul(total, big.NewInt(DaysPerMonth))
+	total.Add(total, big.NewInt(d.Days))
+	total.Mul(total, big.NewInt(SecondsPerDay))
+	total.Add(total, big.NewInt(d.Seconds))
+	total.Mul(total, big.NewInt(nanosPerSecond))
+	total.Add(total, big.NewInt(int64(d.Nanos)))
+
+	if !total.IsInt64() {
+		return 0, fmt.Errorf("duration %s overflows a time.Duration", d.ISO8601())
+	}
+
+	return time.Duration(total.Int64()), nil
+}
+
+// DurationFromTimeDuration converts the given time.Duration into a Duration.
+// The result has only seconds and nanoseconds, with the same sign, and never
+// months or days, whose length depends on the calendar.
+func DurationFromTimeDuration(d time.Duration) Duration {
+	return Duration{
+		Seconds: int64(d / time.Second),
+		Nanos:   int32(d % time.Second),
+	}
+}
+
+// ParseGoDuration parses a duration string in the format accepted by
+// time.ParseDuration, e.g. "1h30m" or "-1.5s", into a Duration.
+// The result has only seconds and nanoseconds.
+func ParseGoDuration(durationStr string) (*Duration, error) {
+	d, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, errors.New("failed to parse the duration string: " + durationStr)
+	}
+
+	duration := DurationFromTimeDuration(d)
+
+	return &duration, nil
+}
+
+// ParseTimeSpan parses a .NET TimeSpan string into a Duration, in the constant
+// format "[-][d.]hh:mm:ss[.fffffff]" or the general format
+// "[-][d:]h:mm:ss[.FFFFFFF]". Seconds and fractions of seconds are optional
+// if there are no days, e.g. "01:30".
+// The result has only days, seconds and nanoseconds.
+func ParseTimeSpan(timeSpanStr string) (*Duration, error) {
+	parseErr := errors.New("failed to parse the TimeSpan string: " + timeSpanStr)
+
+	s, negative := strings.CutPrefix(timeSpanStr, "-")
+	parts := strings.Split(s, ":")
+
+	var daysStr, hoursStr, minutesStr, secondsStr string
+
+	switch len(parts) {
+	case 4:
+		daysStr, hoursStr, minutesStr, secondsStr = parts[0], parts[1], parts[2], parts[3]
+	case 3:
+		hoursStr, minutesStr, secondsStr = parts[0], parts[1], parts[2]
+	case 2:
+		hoursStr, minutesStr = parts[0], parts[1]
+	default:
+		return nil, parseErr
+	}
+
+	if daysStr == "" {
+		if before, after, found := strings.Cut(hoursStr, "."); found {
+			daysStr, hoursStr = before, after
+		}
+	}
+
+	secondsStr, fractionStr, hasFraction := strings.Cut(secondsStr, ".")
+	if hasFraction && (len(fractionStr) == 0 || len(fractionStr) > 9) {
+		return nil, parseErr
+	}
+
+	var days, hours, minutes, seconds, nanos uint64
+
+	for _, field := range []struct {
+		str   string
+		value *uint64
+		max   uint64
+	}{
+		{daysStr, &days, math.MaxInt32},
+		{hoursStr, &hours, 23},
+		{minutesStr, &minutes, 59},
+		{secondsStr, &seconds, 59},
+		{fractionStr, &nanos, nanosPerSecond - 1},
+	} {
+		if field.str == "" {
+			continue
+		}
+
+		value, err := strconv.ParseUint(field.str, 10, 64)
+		if err != nil || value > field.max {
+			return nil, parseErr
+		}
+
+		*field.value = value
+	}
+
+	if hoursStr == "" || minutesStr == "" || (len(parts) > 2 && secondsStr == "") {
+		return nil, parseErr
+	}
+
+	// Right-pad the fraction of seconds into nanoseconds.
+	for range 9 - len(fractionStr) {
+		nanos *= 10
+	}
+
+	duration := Duration{
+		Days:    int64(days),
+		Seconds: int64(hours*3_600 + minutes*60 + seconds),
+		Nanos:   int32(nanos),
+	}
+
+	if negative {
+		duration = duration.Neg()
+	}
+
+	return &duration, nil
+}
+
+// DurationFromSeconds converts the given number of seconds, which may have a
+// fractional part, into a Duration.
+// The result has only seconds and nanoseconds, with the same sign.
+// Returns an error if the number is not finite or overflows an int64.
+func DurationFromSeconds(seconds float64) (*Duration, error) {
+	return durationFromFloat(seconds, nanosPerSecond)
+}
+
+// DurationFromMilliseconds converts the given number of milliseconds, which may
+// have a fractional part, into a Duration.
+// The result has only seconds and nanoseconds, with the same sign.
+// Returns an error if the number is not finite or overflows an int64.
+func DurationFromMilliseconds(milliseconds float64) (*Duration, error) {
+	return durationFromFloat(milliseconds, nanosPerSecond/1_000)
+}
+
+// durationFromFloat converts the given number of units of the given number of
+// nanoseconds into a Duration.
+func durationFromFloat(value float64, unitNanos int64) (*Duration, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || math.Abs(value) >= math.MaxInt64 {
+		return nil, fmt.Errorf("failed to convert the duration number: %v", value)
+	}
+
+	whole, fraction := math.Modf(value)
+	unitsPerSecond := nanosPerSecond / unitNanos
+
+	seconds, nanos := normalizeSecondsAndNanos(
+		int64(whole)/unitsPerSecond,
+		(int64(whole)%unitsPerSecond)*unitNanos+int64(math.Round(fraction*float64(unitNanos))),
+	)
+
+	return &Duration{
+		Seconds: seconds,
+		Nanos:   int32(nanos),
+	}, nil
+}
+
+// normalizeSecondsAndNanos returns the given seconds and nanoseconds with the
+// nanoseconds carried into seconds and with the same sign.
+func normalizeSecondsAndNanos(seconds, nanos int64) (int64, int64) {
+	seconds += nanos / nanosPerSecond
+	nanos %= nanosPerSecond
+
+	switch {
+	case seconds > 0 && nanos < 0:
+		seconds--
+		nanos += nanosPerSecond
+	case seconds < 0 && nanos > 0:
+		seconds++
+		nanos -= nanosPerSecond
+	}
+
+	return seconds, nanos
+}
+
+// abs returns the absolute value of the given integer.
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
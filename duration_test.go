@@ -0,0 +1,251 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDuration_ISO8601(t *testing.T) {
+	tests := map[string]struct {
+		duration Duration
+		want     string
+	}{
+		"zero": {
+			duration: Duration{},
+			want:     "PT0S",
+		},
+		"all_fields": {
+			duration: Duration{Months: 14, Days: 3, Seconds: 3_723, Nanos: 500_000_000},
+			want:     "P14M3DT1H2M3.5S",
+		},
+		"only_nanos": {
+			duration: Duration{Nanos: 1_000},
+			want:     "PT0.000001S",
+		},
+		"negative": {
+			duration: Duration{Days: -1, Seconds: -90},
+			want:     "-P1DT1M30S",
+		},
+		"negative_nanos": {
+			duration: Duration{Nanos: -500_000_000},
+			want:     "-PT0.5S",
+		},
+		"nanos_with_opposite_sign": {
+			duration: Duration{Seconds: 2, Nanos: -500_000_000},
+			want:     "PT1.5S",
+		},
+		"mixed_signs": {
+			duration: Duration{Months: 1, Days: -2},
+			want:     "P1M-2D",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.duration.ISO8601(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuration_ISO8601_RoundTrip(t *testing.T) {
+	for _, duration := range []Duration{
+		{Months: 14, Days: 3, Seconds: 3_723, Nanos: 500_000_000},
+		{Days: -1, Seconds: -90, Nanos: -1},
+		{Seconds: 59},
+	} {
+		parsed, err := ParseISO8601Duration(duration.ISO8601())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(duration, *parsed) {
+			t.Errorf("Expected %#v, got %#v", duration, *parsed)
+		}
+	}
+}
+
+func TestDuration_ToTimeDuration(t *testing.T) {
+	tests := map[string]struct {
+		duration Duration
+		want     time.Duration
+		wantErr  error
+	}{
+		"all_fields": {
+			duration: Duration{Months: 1, Days: 2, Seconds: 3, Nanos: 4},
+			want:     32*24*time.Hour + 3*time.Second + 4,
+		},
+		"negative": {
+			duration: Duration{Days: -1, Nanos: -1},
+			want:     -24*time.Hour - 1,
+		},
+		"overflow": {
+			duration: Duration{Months: 12 * 300},
+			wantErr:  errors.New("duration P3600M overflows a time.Duration"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.duration.ToTimeDuration()
+
+			if !reflect.DeepEqual(tt.wantErr, err) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDurationFromTimeDuration(t *testing.T) {
+	got := DurationFromTimeDuration(-90*time.Second - 5)
+	want := Duration{Seconds: -90, Nanos: -5}
+
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected %#v, got %#v", want, got)
+	}
+}
+
+func TestParseDurations(t *testing.T) {
+	tests := map[string]struct {
+		parse   func(string) (*Duration, error)
+		value   string
+		want    *Duration
+		wantErr error
+	}{
+		"iso8601_negative": {
+			parse: ParseISO8601Duration,
+			value: "-P1M1.5D",
+			want:  &Duration{Months: -1, Days: -1, Seconds: -43_200},
+		},
+		"go": {
+			parse: ParseGoDuration,
+			value: "1h2m3.5s",
+			want:  &Duration{Seconds: 3_723, Nanos: 500_000_000},
+		},
+		"go_negative": {
+			parse: ParseGoDuration,
+			value: "-1.5s",
+			want:  &Duration{Seconds: -1, Nanos: -500_000_000},
+		},
+		"go_invalid": {
+			parse:   ParseGoDuration,
+			value:   "P1D",
+			wantErr: errors.New("failed to parse the duration string: P1D"),
+		},
+		"timespan_constant": {
+			parse: ParseTimeSpan,
+			value: "1.02:03:04.5000000",
+			want:  &Duration{Days: 1, Seconds: 7_384, Nanos: 500_000_000},
+		},
+		"timespan_general": {
+			parse: ParseTimeSpan,
+			value: "-3:4:05:06.25",
+			want:  &Duration{Days: -3, Seconds: -14_706, Nanos: -250_000_000},
+		},
+		"timespan_hours_minutes_seconds": {
+			parse: ParseTimeSpan,
+			value: "1:02:03",
+			want:  &Duration{Seconds: 3_723},
+		},
+		"timespan_hours_minutes": {
+			parse: ParseTimeSpan,
+			value: "01:30",
+			want:  &Duration{Seconds: 5_400},
+		},
+		"timespan_out_of_range": {
+			parse:   ParseTimeSpan,
+			value:   "24:00:00",
+			wantErr: errors.New("failed to parse the TimeSpan string: 24:00:00"),
+		},
+		"timespan_invalid": {
+			parse:   ParseTimeSpan,
+			value:   "1.5",
+			wantErr: errors.New("failed to parse the TimeSpan string: 1.5"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.parse(tt.value)
+
+			if !reflect.DeepEqual(tt.wantErr, err) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("Expected %#v, got %#v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDurationFromNumbers(t *testing.T) {
+	tests := map[string]struct {
+		convert func(float64) (*Duration, error)
+		value   float64
+		want    *Duration
+		wantErr error
+	}{
+		"seconds": {
+			convert: DurationFromSeconds,
+			value:   90.25,
+			want:    &Duration{Seconds: 90, Nanos: 250_000_000},
+		},
+		"negative_seconds": {
+			convert: DurationFromSeconds,
+			value:   -0.5,
+			want:    &Duration{Nanos: -500_000_000},
+		},
+		"milliseconds": {
+			convert: DurationFromMilliseconds,
+			value:   1_500.5,
+			want:    &Duration{Seconds: 1, Nanos: 500_500_000},
+		},
+		"negative_milliseconds": {
+			convert: DurationFromMilliseconds,
+			value:   -2_001,
+			want:    &Duration{Seconds: -2, Nanos: -1_000_000},
+		},
+		"not_finite": {
+			convert: DurationFromSeconds,
+			value:   math.Inf(1),
+			wantErr: errors.New("failed to convert the duration number: +Inf"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.convert(tt.value)
+
+			if !reflect.DeepEqual(tt.wantErr, err) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("Expected %#v, got %#v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryBehavior is how a GetPage request that failed should be retried.
+type RetryBehavior int
+
+const (
+	// RetryNever indicates that the request must not be retried, since it
+	// would fail again until the datasource, entity or auth config changes.
+	RetryNever RetryBehavior = iota
+
+	// RetrySameRequest indicates that the same request may be retried, after
+	// the error's RetryAfter duration if set.
+	RetrySameRequest
+
+	// RetryFromFirstPage indicates that the request must not be retried with
+	// the same cursor, and that the entity must be restarted from its first
+	// page instead.
+	RetryFromFirstPage
+)
+
+// GetRetryBehavior returns how a GetPage request that failed with the given
+// error code should be retried:
+//   - ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+//     ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS and ERROR_CODE_DATASOURCE_FAILED:
+//     RetrySameRequest.
+//   - ERROR_CODE_CURSOR_EXPIRED: RetryFromFirstPage.
+//   - ERROR_CODE_ENTITY_NOT_FOUND, ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS and
+//     all the other codes: RetryNever.
+func GetRetryBehavior(code api_adapter_v1.ErrorCode) RetryBehavior {
+	switch code {
+	case api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED:
+		return RetrySameRequest
+	case api_adapter_v1.ErrorCode_ERROR_CODE_CURSOR_EXPIRED:
+		return RetryFromFirstPage
+	default:
+		return RetryNever
+	}
+}
+
+// connectorError is implemented by the errors returned when a connector failed
+// to send a request to a datasource, e.g. client.ProxyError.
+// It is matched by method rather than by type, so that the framework package
+// doesn't depend on the connector client.
+type connectorError interface {
+	error
+
+	// ConnectorError returns true if the connector failed to send the request.
+	ConnectorError() bool
+}
+
+// ErrorFromGoError returns an error classifying the given error returned while
+// sending a request to a datasource, e.g. by an http.Client, a net.Dialer or
+// client.Transport, or nil if err is nil.
+//
+// Timeouts, cancellations, connection failures, temporary DNS failures and an
+// unreachable proxy or connector are DATASOURCE_TEMPORARILY_UNAVAILABLE and
+// Transient. Unknown hosts are DATASOURCE_PERMANENTLY_UNAVAILABLE. TLS
+// certificate verification failures are DATASOURCE_AUTHENTICATION_FAILED. Other
+// errors are INTERNAL.
+//
+// The message of the returned error is generic and never contains the message
+// of the given error, which may contain URLs with embedded credentials.
+// The error's Reason identifies the failure more precisely, e.g. "DNS_NOT_FOUND".
+func ErrorFromGoError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		proxyErr        connectorError
+		dnsErr          *net.DNSError
+		certVerifyErr   *tls.CertificateVerificationError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		certInvalidErr  x509.CertificateInvalidError
+		recordHeaderErr tls.RecordHeaderError
+		netErr          net.Error
+		opErr           *net.OpError
+		grpcStatusErr   interface{ GRPCStatus() *status.Status }
+	)
+
+	switch {
+	case errors.As(err, &proxyErr) && proxyErr.ConnectorError():
+		return temporarilyUnavailableError("CONNECTOR_ERROR", "Connector failed to send the request to the datasource. Check the connector status and try again.")
+
+	case errors.As(err, &certVerifyErr), errors.As(err, &unknownAuthErr),
+		errors.As(err, &hostnameErr), errors.As(err, &certInvalidErr):
+		return &Error{
+			Message: "Failed to verify the TLS certificate of the datasource. Check datasource configuration details and try again.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			Reason:  "TLS_CERTIFICATE_INVALID",
+		}
+
+	case errors.As(err, &recordHeaderErr):
+		return &Error{
+			Message: "Datasource did not respond to the TLS handshake. Check the datasource address and try again.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			Reason:  "TLS_HANDSHAKE_FAILED",
+		}
+
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsNotFound {
+			return &Error{
+				Message: "Failed to resolve the datasource host name. Check the datasource address and try again.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PERMANENTLY_UNAVAILABLE,
+				Reason:  "DNS_NOT_FOUND",
+			}
+		}
+
+		return temporarilyUnavailableError("DNS_TEMPORARY_FAILURE", "Failed to resolve the datasource host name; try again later.")
+
+	case errors.Is(err, context.DeadlineExceeded):
+		return temporarilyUnavailableError("TIMEOUT", "Request to datasource timed out; try again later.")
+
+	case errors.Is(err, context.Canceled):
+		return temporarilyUnavailableError("CANCELED", "Request to datasource was canceled; try again later.")
+
+	case errors.As(err, &grpcStatusErr):
+		switch grpcStatusErr.GRPCStatus().Code() {
+		case codes.Unavailable:
+			return temporarilyUnavailableError("PROXY_UNAVAILABLE", "Proxy to the datasource is unreachable; try again later.")
+		case codes.DeadlineExceeded:
+			return temporarilyUnavailableError("TIMEOUT", "Request to datasource timed out; try again later.")
+		case codes.Canceled:
+			return temporarilyUnavailableError("CANCELED", "Request to datasource was canceled; try again later.")
+		default:
+			return &Error{
+				Message: "Proxy to the datasource failed to handle the request.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Reason:  "PROXY_ERROR",
+			}
+		}
+
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return temporarilyUnavailableError("TIMEOUT", "Request to datasource timed out; try again later.")
+
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return temporarilyUnavailableError("CONNECTION_REFUSED", "Datasource refused the connection; try again later.")
+
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.EPIPE),
+		errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return temporarilyUnavailableError("CONNECTION_RESET", "Datasource closed the connection unexpectedly; try again later.")
+
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return temporarilyUnavailableError("NETWORK_UNREACHABLE", "Datasource is unreachable; try again later.")
+
+	case errors.As(err, &opErr):
+		return temporarilyUnavailableError("NETWORK_ERROR", "Failed to connect to the datasource; try again later.")
+
+	default:
+		return &Error{
+			Message: "Request to datasource failed unexpectedly.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			Reason:  "UNKNOWN",
+		}
+	}
+}
+
+// temporarilyUnavailableError returns a transient
+// DATASOURCE_TEMPORARILY_UNAVAILABLE error with the given reason and message.
+func temporarilyUnavailableError(reason, message string) *Error {
+	return &Error{
+		Message:   message,
+		Code:      api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+		Reason:    reason,
+		Transient: true,
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rpcvalue allocates the RPC messages of the objects in GetPage
+// responses, shared by the server's conversion of adapter Objects and by
+// the PageBuilder used by ProtoAdapters.
+package rpcvalue
+
+import (
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Null is the null attribute value, shared by all the null values.
+var Null = &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_NullValue{
+	NullValue: &emptypb.Empty{},
+}}
+
+// valueChunkSize is the number of messages of each type allocated at once by
+// a valueAllocator.
+const valueChunkSize = 256
+
+// chunk is a slice of preallocated values of type T.
+type chunk[T any] []T
+
+// new returns a pointer to a zero value of type T, allocating a new chunk of
+// values if the current chunk is exhausted.
+func (c *chunk[T]) new() *T {
+	if len(*c) == 0 {
+		*c = make([]T, valueChunkSize)
+	}
+
+	v := &(*c)[0]
+	*c = (*c)[1:]
+
+	return v
+}
+
+// Allocator allocates the RPC messages of the objects in a response in
+// chunks, instead of one heap allocation per message.
+//
+// The messages are part of the response, so unlike with a sync.Pool they are
+// never reused. A chunk is garbage collected once none of its messages are
+// referenced anymore, i.e. once the response has been sent.
+//
+// An Allocator is not safe for concurrent use.
+type Allocator struct {
+	objects    chunk[api_adapter_v1.Object]
+	attributes chunk[api_adapter_v1.Attribute]
+	values     chunk[api_adapter_v1.AttributeValue]
+
+	attributeLists []*api_adapter_v1.Attribute
+	valueLists     []*api_adapter_v1.AttributeValue
+
+	boolValues     chunk[api_adapter_v1.AttributeValue_BoolValue]
+	dateTimeValues chunk[api_adapter_v1.AttributeValue_DatetimeValue]
+	durationValues chunk[api_adapter_v1.AttributeValue_DurationValue]
+	doubleValues   chunk[api_adapter_v1.AttributeValue_DoubleValue]
+	int64Values    chunk[api_adapter_v1.AttributeValue_Int64Value]
+	stringValues   chunk[api_adapter_v1.AttributeValue_StringValue]
+	bytesValues    chunk[api_adapter_v1.AttributeValue_BytesValue]
+	uuidValues     chunk[api_adapter_v1.AttributeValue_UuidValue]
+
+	dateTimes  chunk[api_adapter_v1.DateTime]
+	timestamps chunk[timestamppb.Timestamp]
+	durations  chunk[api_adapter_v1.Duration]
+}
+
+// newSlice returns a non-nil slice of length n taken from the given slice of
+// preallocated elements, allocating a new slice of elements if the current one
+// is too short.
+func newSlice[T any](elements *[]T, n int) []T {
+	if n > valueChunkSize {
+		return make([]T, n)
+	}
+
+	if *elements == nil || len(*elements) < n {
+		*elements = make([]T, valueChunkSize)
+	}
+
+	// Limit the capacity to prevent appends from overwriting the next slices.
+	slice := (*elements)[:n:n]
+	*elements = (*elements)[n:]
+
+	return slice
+}
+
+// NewValueList returns a non-nil list of attribute values of the given length.
+func (a *Allocator) NewValueList(n int) []*api_adapter_v1.AttributeValue {
+	return newSlice(&a.valueLists, n)
+}
+
+// NewAttributeList returns an empty list of attributes with the given
+// capacity.
+func (a *Allocator) NewAttributeList(capacity int) []*api_adapter_v1.Attribute {
+	return newSlice(&a.attributeLists, capacity)[:0]
+}
+
+// NewObject returns a new RPC object.
+func (a *Allocator) NewObject() *api_adapter_v1.Object {
+	return a.objects.new()
+}
+
+// NewAttribute returns a new RPC attribute with the given ID and values.
+func (a *Allocator) NewAttribute(id string, values []*api_adapter_v1.AttributeValue) *api_adapter_v1.Attribute {
+	attribute := a.attributes.new()
+	attribute.Id = id
+	attribute.Values = values
+
+	return attribute
+}
+
+// NewBoolValue returns a new bool attribute value.
+func (a *Allocator) NewBoolValue(v bool) *api_adapter_v1.AttributeValue {
+	wrapper := a.boolValues.new()
+	wrapper.BoolValue = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewDateTimeValue returns a new date-time attribute value.
+func (a *Allocator) NewDateTimeValue(v time.Time) *api_adapter_v1.AttributeValue {
+	_, timezoneOffset := v.Zone()
+
+	timestamp := a.timestamps.new()
+	timestamp.Seconds = v.Unix()
+	timestamp.Nanos = int32(v.Nanosecond())
+
+	dateTime := a.dateTimes.new()
+	dateTime.Timestamp = timestamp
+	dateTime.TimezoneOffset = int32(timezoneOffset)
+
+	wrapper := a.dateTimeValues.new()
+	wrapper.DatetimeValue = dateTime
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewDurationValue returns a new duration attribute value, which duration is
+// the sum of the given numbers of nanoseconds, seconds, days and months.
+func (a *Allocator) NewDurationValue(nanos int32, seconds, days, months int64) *api_adapter_v1.AttributeValue {
+	duration := a.durations.new()
+	duration.Nanos = nanos
+	duration.Seconds = seconds
+	duration.Days = days
+	duration.Months = months
+
+	wrapper := a.durationValues.new()
+	wrapper.DurationValue = duration
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewDoubleValue returns a new double attribute value.
+func (a *Allocator) NewDoubleValue(v float64) *api_adapter_v1.AttributeValue {
+	wrapper := a.doubleValues.new()
+	wrapper.DoubleValue = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewInt64Value returns a new int64 attribute value.
+func (a *Allocator) NewInt64Value(v int64) *api_adapter_v1.AttributeValue {
+	wrapper := a.int64Values.new()
+	wrapper.Int64Value = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewStringValue returns a new string attribute value.
+func (a *Allocator) NewStringValue(v string) *api_adapter_v1.AttributeValue {
+	wrapper := a.stringValues.new()
+	wrapper.StringValue = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewBytesValue returns a new bytes attribute value, or Null if the given
+// slice is nil.
+func (a *Allocator) NewBytesValue(v []byte) *api_adapter_v1.AttributeValue {
+	if v == nil {
+		return Null
+	}
+
+	wrapper := a.bytesValues.new()
+	wrapper.BytesValue = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
+
+// NewUUIDValue returns a new UUID attribute value, given the canonical string
+// representation of the UUID.
+func (a *Allocator) NewUUIDValue(v string) *api_adapter_v1.AttributeValue {
+	wrapper := a.uuidValues.new()
+	wrapper.UuidValue = v
+
+	value := a.values.new()
+	value.Value = wrapper
+
+	return value
+}
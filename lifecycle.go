@@ -0,0 +1,67 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+)
+
+// Lifecycle may be implemented by an Adapter to create resources once per
+// datasource, e.g. HTTP clients, LDAP connections or OAuth tokens, and reuse
+// them across the GetPage requests for that datasource.
+//
+// The server calls Init before the first GetPage request for a datasource,
+// and passes the returned resource to GetPage in the request context, to be
+// retrieved with DatasourceResource.
+// Resources are keyed by the datasource ID, config, address, auth credentials
+// and connector info, so a new resource is initialized whenever any of them
+// change, e.g. when credentials are rotated, and the previous resource of the
+// datasource is closed.
+//
+// Resources are shared by concurrent GetPage requests for the same datasource,
+// so they must be safe for concurrent use.
+type Lifecycle[Config any] interface {
+	// Init creates the resource for the datasource of the given request.
+	// Returns an error if the resource cannot be created, which is returned in
+	// the response of the GetPage request. Errors are not cached: Init is
+	// called again on the next request.
+	Init(ctx context.Context, request *Request[Config]) (resource any, err *Error)
+
+	// Close releases the given resource returned by Init.
+	// Called once the resource is no longer used by any GetPage request, and
+	// either has been idle for too long, has been replaced after the datasource
+	// changed, or the server is stopped.
+	Close(resource any)
+}
+
+// datasourceResourceKey is the key for storing a datasource resource in a
+// derived context.
+type datasourceResourceKey struct{}
+
+// WithDatasourceResource returns a derived context carrying the given resource
+// returned by Lifecycle.Init.
+func WithDatasourceResource(ctx context.Context, resource any) context.Context {
+	return context.WithValue(ctx, datasourceResourceKey{}, resource)
+}
+
+// DatasourceResource returns the resource returned by Lifecycle.Init for the
+// datasource of the GetPage request with the given context.
+// Returns false if the context carries no resource, or if the resource is not
+// of type T.
+func DatasourceResource[T any](ctx context.Context) (resource T, ok bool) {
+	resource, ok = ctx.Value(datasourceResourceKey{}).(T)
+
+	return
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDatasourceResource(t *testing.T) {
+	client := &http.Client{}
+
+	if _, ok := DatasourceResource[*http.Client](context.Background()); ok {
+		t.Error("Expected no resource in a background context")
+	}
+
+	ctx := WithDatasourceResource(context.Background(), client)
+
+	got, ok := DatasourceResource[*http.Client](ctx)
+	if !ok || got != client {
+		t.Errorf("Expected the client, got %v, %v", got, ok)
+	}
+
+	if _, ok := DatasourceResource[string](ctx); ok {
+		t.Error("Expected no resource of another type")
+	}
+}
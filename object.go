@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/sosodev/duration"
@@ -32,6 +33,7 @@ type Object map[string]any
 
 // Duration is the value of an attribute of type duration.
 // It is the sum of all the fields' durations.
+// All the fields of a negative duration should be negative or zero.
 type Duration struct {
 	// Nanos is a duration as a number of nanoseconds.
 	Nanos int32 `json:"nanos,omitempty"`
@@ -46,9 +48,12 @@ type Duration struct {
 	Months int64 `json:"months,omitempty"`
 }
 
-// ParseDuration parses a valid ISO8601 duration string into a Duration.
-// Years as duration is not supported.
-// Fractional components of durations are not supported.
+// ParseISO8601Duration parses a valid ISO8601 duration string into a Duration,
+// e.g. "P1Y2M3DT4H5M6.5S" or "-PT1H".
+// Years are converted into 12 months, weeks into 7 days, and hours and minutes
+// into seconds. Fractional months and days are converted using DaysPerMonth
+// and SecondsPerDay, and fractional seconds into nanoseconds.
+// All the fields of a negative duration are negative or zero.
 func ParseISO8601Duration(durationStr string) (*Duration, error) {
 	d, err := duration.Parse(durationStr)
 	if err != nil {
@@ -63,29 +68,40 @@ func ParseISO8601Duration(durationStr string) (*Duration, error) {
 
 	// Round the numbers of months, days, seconds, and nanoseconds.
 	months, monthsFraction := math.Modf(d.Months)
-	d.Days += monthsFraction * 30.0
+	d.Days += monthsFraction * DaysPerMonth
 
 	days, daysFraction := math.Modf(d.Days)
-	d.Seconds += daysFraction * 24.0 * 3_600.0
+	d.Seconds += daysFraction * SecondsPerDay
 
 	seconds, secondsFraction := math.Modf(d.Seconds)
 	nanos := secondsFraction * 1_000_000_000.0
 
-	return &Duration{
+	parsed := Duration{
 		Months:  int64(months),
 		Days:    int64(days),
 		Seconds: int64(seconds),
-		Nanos:   int32(nanos),
-	}, nil
+		Nanos:   int32(math.Round(nanos)),
+	}
+
+	if parsed.Nanos == nanosPerSecond {
+		parsed.Seconds++
+		parsed.Nanos = 0
+	}
+
+	if d.Negative {
+		parsed = parsed.Neg()
+	}
+
+	return &parsed, nil
 }
 
 // AttributeValue is the set of types allowed for values of non-list attributes
 // in an Object.
 type AttributeValue interface {
 	// Types of non-list attribute values.
-	bool | time.Time | Duration | float64 | int64 | string |
+	bool | time.Time | Duration | float64 | int64 | string | []byte | UUID |
 		// Types of list attribute values.
-		[]bool | []time.Time | []Duration | []float64 | []int64 | []string
+		[]bool | []time.Time | []Duration | []float64 | []int64 | []string | [][]byte | []UUID
 }
 
 // AddAttribute adds a attribute into the given object.
@@ -102,6 +118,19 @@ func AddAttribute[Value AttributeValue](object Object, attributeExternalId strin
 	return nil
 }
 
+// ChildObjects is the value of a child entity in an Object when its child
+// objects are split across pages: Objects is the page of child objects
+// returned in the parent object, and NextCursor identifies the next page.
+type ChildObjects struct {
+	// Objects is the page of child objects returned in the parent object.
+	Objects []Object
+
+	// NextCursor is the cursor that identifies the first child object of the
+	// next page, which is requested with a ChildPageRequest.
+	// Optional. If not set, this page is the last page of child objects.
+	NextCursor string
+}
+
 // AddChildObjects adds child objects into the given object.
 // If child objects have already been added for the same entity external ID,
 // the given objects are appended to the list of child objects in that entity.
@@ -114,15 +143,154 @@ func AddChildObjects(object Object, entityExternalId string, childObjects ...Obj
 
 	value, found := object[entityExternalId]
 	if found {
-		currentChildObjects, isListOfObjects := value.([]Object)
-		if !isListOfObjects {
+		switch currentChildObjects := value.(type) {
+		case []Object:
+			object[entityExternalId] = append(currentChildObjects, childObjects...)
+		case ChildObjects:
+			currentChildObjects.Objects = append(currentChildObjects.Objects, childObjects...)
+			object[entityExternalId] = currentChildObjects
+		default:
 			return fmt.Errorf("attribute already exists with that external ID in object: %s", entityExternalId)
 		}
-
-		object[entityExternalId] = append(currentChildObjects, childObjects...)
 	} else {
 		object[entityExternalId] = childObjects
 	}
 
 	return nil
 }
+
+// SetChildObjectsCursor sets the cursor of the next page of child objects of
+// the given child entity in the given object, i.e. indicates that the child
+// objects added into the object are not all the child objects of that entity.
+// Returns an error if an attribute has already been added with the same
+// external ID.
+func SetChildObjectsCursor(object Object, entityExternalId string, nextCursor string) error {
+	childObjects, found, isChildObjects := getChildObjects(object, entityExternalId)
+	if found && !isChildObjects {
+		return fmt.Errorf("attribute already exists with that external ID in object: %s", entityExternalId)
+	}
+
+	childObjects.NextCursor = nextCursor
+	object[entityExternalId] = childObjects
+
+	return nil
+}
+
+// SplitChildObjects keeps at most maxObjects child objects of the given child
+// entity in the given object, and sets the cursor of the next page of child
+// objects to the offset of the first child object that is removed.
+// The following pages are returned by NewChildPageObject, given the same
+// object with all its child objects.
+// Returns an error if maxObjects is not positive, if an attribute has already
+// been added with the same external ID, or if a cursor is already set for the
+// child objects.
+func SplitChildObjects(object Object, entityExternalId string, maxObjects int64) error {
+	if maxObjects <= 0 {
+		return fmt.Errorf("invalid maximum number of child objects: %d", maxObjects)
+	}
+
+	childObjects, found, isChildObjects := getChildObjects(object, entityExternalId)
+
+	switch {
+	case !found:
+		return nil
+	case !isChildObjects:
+		return fmt.Errorf("attribute already exists with that external ID in object: %s", entityExternalId)
+	case childObjects.NextCursor != "":
+		return fmt.Errorf("child objects already have a next cursor in object: %s", entityExternalId)
+	case int64(len(childObjects.Objects)) <= maxObjects:
+		return nil
+	}
+
+	object[entityExternalId] = ChildObjects{
+		Objects:    childObjects.Objects[:maxObjects],
+		NextCursor: strconv.FormatInt(maxObjects, 10),
+	}
+
+	return nil
+}
+
+// NewChildPageObject returns the object to return in the page requested by the
+// given child page request for the given entity: an object containing the
+// unique ID attributes of the given parent object, and the page of child
+// objects of the requested child entity identified by the request's cursor,
+// which contains at most pageSize child objects.
+// The given parent object must contain all its child objects of the requested
+// child entity, and cursors must have been returned by SplitChildObjects or
+// by this function.
+// Returns an error if the child entity is not a child entity of the given
+// entity, if the parent object contains no unique ID attribute, or if the
+// cursor is invalid.
+func NewChildPageObject(entity *EntityConfig, parent Object, childPage *ChildPageRequest, pageSize int64) (Object, error) {
+	childEntity := getChildEntityConfig(entity, childPage.ChildEntityExternalID)
+	if childEntity == nil {
+		return nil, fmt.Errorf("entity %s is not a child entity of entity %s", childPage.ChildEntityExternalID, entity.ExternalId)
+	}
+
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("invalid page size: %d", pageSize)
+	}
+
+	object := make(Object)
+
+	for _, attribute := range entity.Attributes {
+		if value, found := parent[attribute.ExternalId]; found && attribute.UniqueId {
+			object[attribute.ExternalId] = value
+		}
+	}
+
+	if len(object) == 0 {
+		return nil, fmt.Errorf("parent object contains no unique ID attribute for entity %s", entity.ExternalId)
+	}
+
+	childObjects, found, isChildObjects := getChildObjects(parent, childEntity.ExternalId)
+	if found && !isChildObjects {
+		return nil, fmt.Errorf("attribute already exists with that external ID in object: %s", childEntity.ExternalId)
+	}
+
+	offset := int64(0)
+
+	if childPage.Cursor != "" {
+		var err error
+
+		offset, err = strconv.ParseInt(childPage.Cursor, 10, 64)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid child objects cursor: %s", childPage.Cursor)
+		}
+	}
+
+	count := int64(len(childObjects.Objects))
+	start := min(offset, count)
+	end := start + min(pageSize, count-start)
+
+	page := ChildObjects{
+		Objects: childObjects.Objects[start:end],
+	}
+
+	if end < count {
+		page.NextCursor = strconv.FormatInt(end, 10)
+	}
+
+	object[childEntity.ExternalId] = page
+
+	return object, nil
+}
+
+// getChildObjects returns the child objects of the given child entity in the
+// given object, whether the object contains a value for that external ID, and
+// whether that value contains child objects.
+func getChildObjects(object Object, entityExternalId string) (childObjects ChildObjects, found, isChildObjects bool) {
+	value, found := object[entityExternalId]
+	if !found {
+		return ChildObjects{}, false, false
+	}
+
+	switch v := value.(type) {
+	case []Object:
+		return ChildObjects{Objects: v}, true, true
+	case ChildObjects:
+		return v, true, true
+	default:
+		return ChildObjects{}, true, false
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StructTag is the key of the struct field tags that map struct fields to
+// attribute and child entity external IDs in ObjectFromStruct.
+const StructTag = "sgnl"
+
+// structFieldsCache maps each struct type to its structFields.
+var structFieldsCache sync.Map
+
+// structFields maps the external IDs of the tagged fields of a struct type to
+// the index sequences of the fields.
+type structFields map[string][]int
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[Duration]()
+	uuidType     = reflect.TypeFor[UUID]()
+	bytesType    = reflect.TypeFor[[]byte]()
+)
+
+// ObjectFromStruct returns the object for the given entity with the values of
+// the fields of the given struct or pointer to struct.
+//
+// Struct fields are mapped to the attributes and child entities with the
+// external ID set in their `sgnl` tag, e.g. `sgnl:"displayName"`. Fields
+// without a tag or tagged `sgnl:"-"` are ignored. The fields of embedded
+// structs are mapped as if they were fields of the outer struct.
+//
+// Only the attributes and child entities in the entity config are returned.
+// Nil pointers, slices and maps, and empty slices, are omitted.
+//
+// The type of each field mapped to an attribute must match the attribute's
+// type, or its element type for list attributes:
+//   - AttributeTypeBool: bool.
+//   - AttributeTypeDateTime: time.Time.
+//   - AttributeTypeDouble: float32 or float64.
+//   - AttributeTypeDuration: Duration or time.Duration.
+//   - AttributeTypeInt64: any signed or unsigned integer type, except uint
+//     and uint64.
+//   - AttributeTypeString: string.
+//   - AttributeTypeBytes: []byte.
+//   - AttributeTypeUUID: UUID.
+//
+// Types with these underlying types and pointers to these types are accepted.
+// The type of each field mapped to a child entity must be a struct, pointer to
+// struct, or slice of structs or pointers to structs, which are converted
+// into child objects recursively.
+//
+// Returns an error if a field type doesn't match the entity config.
+// The fields of each struct type are inspected once and cached.
+func ObjectFromStruct(entity *EntityConfig, v any) (Object, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, fmt.Errorf("cannot convert a nil %s into an object", value.Type())
+		}
+
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot convert a value of type %T into an object: not a struct", v)
+	}
+
+	return objectFromStruct(entity, value)
+}
+
+// objectFromStruct returns the object for the given entity with the values of
+// the fields of the given struct value.
+func objectFromStruct(entity *EntityConfig, value reflect.Value) (Object, error) {
+	fields := getStructFields(value.Type())
+	object := make(Object, len(entity.Attributes)+len(entity.ChildEntities))
+
+	for _, attribute := range entity.Attributes {
+		fieldValue, found := getStructFieldValue(value, fields[attribute.ExternalId])
+		if !found {
+			continue
+		}
+
+		attributeValue, err := convertStructFieldValue(attribute, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s cannot be mapped to attribute %s: %w",
+				value.Type(), getStructFieldName(value.Type(), fields[attribute.ExternalId]), attribute.ExternalId, err)
+		}
+
+		if attributeValue != nil {
+			object[attribute.ExternalId] = attributeValue
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		fieldValue, found := getStructFieldValue(value, fields[childEntity.ExternalId])
+		if !found {
+			continue
+		}
+
+		childObjects, err := convertStructFieldChildObjects(childEntity, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s cannot be mapped to child entity %s: %w",
+				value.Type(), getStructFieldName(value.Type(), fields[childEntity.ExternalId]), childEntity.ExternalId, err)
+		}
+
+		if err := AddChildObjects(object, childEntity.ExternalId, childObjects...); err != nil {
+			return nil, err
+		}
+	}
+
+	return object, nil
+}
+
+// getStructFields returns the tagged fields of the given struct type.
+func getStructFields(structType reflect.Type) structFields {
+	if cached, found := structFieldsCache.Load(structType); found {
+		return cached.(structFields)
+	}
+
+	fields := make(structFields)
+
+	for _, field := range reflect.VisibleFields(structType) {
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+
+		externalID := field.Tag.Get(StructTag)
+		if externalID == "" || externalID == "-" {
+			continue
+		}
+
+		// The shallowest field wins if several fields have the same external
+		// ID, like for encoding/json.
+		if existing, found := fields[externalID]; found && len(existing) <= len(field.Index) {
+			continue
+		}
+
+		fields[externalID] = field.Index
+	}
+
+	cached, _ := structFieldsCache.LoadOrStore(structType, fields)
+
+	return cached.(structFields)
+}
+
+// getStructFieldName returns the dotted name of the field of the given struct
+// type with the given index sequence.
+func getStructFieldName(structType reflect.Type, index []int) string {
+	names := make([]string, 0, len(index))
+
+	for i := range index {
+		names = append(names, structType.FieldByIndex(index[:i+1]).Name)
+	}
+
+	return strings.Join(names, ".")
+}
+
+// getStructFieldValue returns the value of the field of the given struct value
+// with the given index sequence, or false if there is no such field or if it
+// is in a nil embedded struct pointer.
+func getStructFieldValue(value reflect.Value, index []int) (reflect.Value, bool) {
+	if index == nil {
+		return reflect.Value{}, false
+	}
+
+	fieldValue, err := value.FieldByIndexErr(index)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+
+	return fieldValue, true
+}
+
+// convertStructFieldValue converts the value of a struct field into a value
+// for the given attribute, or returns nil if the value is null.
+func convertStructFieldValue(attribute *AttributeConfig, value reflect.Value) (any, error) {
+	value, isNil := derefValue(value)
+	if isNil {
+		return nil, nil
+	}
+
+	if !attribute.List {
+		return convertStructFieldSingleValue(attribute.Type, value)
+	}
+
+	if (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) ||
+		(attribute.Type == AttributeTypeBytes && isBytesType(value.Type())) {
+		return nil, fmt.Errorf("type %s does not match type %s (list=true)", value.Type(), getAttributeTypeName(attribute.Type))
+	}
+
+	if value.Len() == 0 {
+		return nil, nil
+	}
+
+	switch attribute.Type {
+	case AttributeTypeBool:
+		return convertStructFieldListValue[bool](attribute.Type, value)
+	case AttributeTypeDateTime:
+		return convertStructFieldListValue[time.Time](attribute.Type, value)
+	case AttributeTypeDouble:
+		return convertStructFieldListValue[float64](attribute.Type, value)
+	case AttributeTypeDuration:
+		return convertStructFieldListValue[Duration](attribute.Type, value)
+	case AttributeTypeInt64:
+		return convertStructFieldListValue[int64](attribute.Type, value)
+	case AttributeTypeString:
+		return convertStructFieldListValue[string](attribute.Type, value)
+	case AttributeTypeBytes:
+		return convertStructFieldListValue[[]byte](attribute.Type, value)
+	case AttributeTypeUUID:
+		return convertStructFieldListValue[UUID](attribute.Type, value)
+	default:
+		return nil, fmt.Errorf("unsupported attribute type %d", attribute.Type)
+	}
+}
+
+// convertStructFieldListValue converts the elements of a slice or array struct
+// field value into a list of values of the given attribute type.
+// Null elements are omitted.
+func convertStructFieldListValue[Element any](attributeType AttributeType, value reflect.Value) (any, error) {
+	list := make([]Element, 0, value.Len())
+
+	for i := range value.Len() {
+		element, isNil := derefValue(value.Index(i))
+		if isNil {
+			continue
+		}
+
+		converted, err := convertStructFieldSingleValue(attributeType, element)
+		if err != nil {
+			return nil, fmt.Errorf("list element: %w", err)
+		}
+
+		list = append(list, converted.(Element))
+	}
+
+	return list, nil
+}
+
+// convertStructFieldSingleValue converts the given non-pointer struct field
+// value into a value of the given attribute type.
+func convertStructFieldSingleValue(attributeType AttributeType, value reflect.Value) (any, error) {
+	valueType := value.Type()
+
+	switch attributeType {
+	case AttributeTypeBool:
+		if value.Kind() == reflect.Bool {
+			return value.Bool(), nil
+		}
+	case AttributeTypeDateTime:
+		if valueType.ConvertibleTo(timeType) && value.Kind() == reflect.Struct {
+			return value.Convert(timeType).Interface(), nil
+		}
+	case AttributeTypeDouble:
+		if value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64 {
+			return value.Float(), nil
+		}
+	case AttributeTypeDuration:
+		switch {
+		case valueType == reflect.TypeFor[time.Duration]():
+			d := time.Duration(value.Int())
+
+			return Duration{Seconds: int64(d / time.Second), Nanos: int32(d % time.Second)}, nil
+		case valueType.ConvertibleTo(durationType) && value.Kind() == reflect.Struct:
+			return value.Convert(durationType).Interface(), nil
+		}
+	case AttributeTypeInt64:
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return value.Int(), nil
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			return int64(value.Uint()), nil
+		}
+	case AttributeTypeString:
+		if value.Kind() == reflect.String {
+			return value.String(), nil
+		}
+	case AttributeTypeBytes:
+		if isBytesType(valueType) {
+			return value.Bytes(), nil
+		}
+	case AttributeTypeUUID:
+		if valueType.ConvertibleTo(uuidType) && value.Kind() == reflect.Array {
+			return value.Convert(uuidType).Interface(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("type %s does not match type %s (list=false)", valueType, getAttributeTypeName(attributeType))
+}
+
+// convertStructFieldChildObjects converts the value of a struct field into the
+// child objects of the given child entity.
+func convertStructFieldChildObjects(childEntity *EntityConfig, value reflect.Value) ([]Object, error) {
+	value, isNil := derefValue(value)
+	if isNil {
+		return nil, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		childObject, err := objectFromStruct(childEntity, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Object{childObject}, nil
+	case reflect.Slice, reflect.Array:
+		childObjects := make([]Object, 0, value.Len())
+
+		for i := range value.Len() {
+			element, isNil := derefValue(value.Index(i))
+			if isNil {
+				continue
+			}
+
+			if element.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("type %s is not a list of structs", value.Type())
+			}
+
+			childObject, err := objectFromStruct(childEntity, element)
+			if err != nil {
+				return nil, err
+			}
+
+			childObjects = append(childObjects, childObject)
+		}
+
+		return childObjects, nil
+	default:
+		return nil, fmt.Errorf("type %s is not a struct or a list of structs", value.Type())
+	}
+}
+
+// derefValue dereferences the given value while it is a pointer or an
+// interface, and returns whether it is nil.
+func derefValue(value reflect.Value) (reflect.Value, bool) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return value, true
+		}
+
+		value = value.Elem()
+	}
+
+	if (value.Kind() == reflect.Slice || value.Kind() == reflect.Map) && value.IsNil() {
+		return value, true
+	}
+
+	return value, false
+}
+
+// isBytesType returns whether the given type is a byte slice type.
+func isBytesType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && t.ConvertibleTo(bytesType)
+}
+
+// getAttributeTypeName returns the name of the given attribute type, for
+// error messages.
+func getAttributeTypeName(attributeType AttributeType) string {
+	switch attributeType {
+	case AttributeTypeBool:
+		return "bool"
+	case AttributeTypeDateTime:
+		return "datetime"
+	case AttributeTypeDouble:
+		return "double"
+	case AttributeTypeDuration:
+		return "duration"
+	case AttributeTypeInt64:
+		return "int64"
+	case AttributeTypeString:
+		return "string"
+	case AttributeTypeBytes:
+		return "bytes"
+	case AttributeTypeUUID:
+		return "uuid"
+	default:
+		return fmt.Sprintf("%d", attributeType)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testGroup struct {
+	ID   string `sgnl:"id"`
+	Name string `sgnl:"name"`
+}
+
+type testAuditInfo struct {
+	CreatedAt time.Time  `sgnl:"createdAt"`
+	UpdatedAt *time.Time `sgnl:"updatedAt"`
+}
+
+type testUser struct {
+	*testAuditInfo
+
+	ID         string        `sgnl:"id"`
+	Active     bool          `sgnl:"active"`
+	Age        int32         `sgnl:"age"`
+	Score      float32       `sgnl:"score"`
+	Timeout    time.Duration `sgnl:"timeout"`
+	GUID       UUID          `sgnl:"objectGUID"`
+	Emails     []string      `sgnl:"emails"`
+	Manager    *string       `sgnl:"manager"`
+	Groups     []*testGroup  `sgnl:"groups"`
+	Department testGroup     `sgnl:"department"`
+	Password   string        `sgnl:"-"`
+	Untagged   string
+}
+
+func TestObjectFromStruct(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	user := &testUser{
+		testAuditInfo: &testAuditInfo{CreatedAt: createdAt},
+		ID:            "alice",
+		Active:        true,
+		Age:           42,
+		Score:         1.5,
+		Timeout:       90*time.Second + 5,
+		GUID:          UUID{0x01},
+		Emails:        []string{"alice@example.com"},
+		Groups:        []*testGroup{{ID: "g1", Name: "Admins"}, nil, {ID: "g2"}},
+		Department:    testGroup{ID: "d1", Name: "Sales"},
+		Password:      "secret",
+	}
+
+	tests := map[string]struct {
+		entity     *EntityConfig
+		value      any
+		wantObject Object
+		wantErr    error
+	}{
+		"all_types": {
+			entity: &EntityConfig{
+				ExternalId: "users",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+					{ExternalId: "active", Type: AttributeTypeBool},
+					{ExternalId: "age", Type: AttributeTypeInt64},
+					{ExternalId: "score", Type: AttributeTypeDouble},
+					{ExternalId: "timeout", Type: AttributeTypeDuration},
+					{ExternalId: "objectGUID", Type: AttributeTypeUUID},
+					{ExternalId: "emails", Type: AttributeTypeString, List: true},
+					{ExternalId: "manager", Type: AttributeTypeString},
+					{ExternalId: "createdAt", Type: AttributeTypeDateTime},
+					{ExternalId: "updatedAt", Type: AttributeTypeDateTime},
+					{ExternalId: "unknown", Type: AttributeTypeString},
+				},
+				ChildEntities: []*EntityConfig{
+					{
+						ExternalId: "groups",
+						Attributes: []*AttributeConfig{
+							{ExternalId: "id", Type: AttributeTypeString},
+						},
+					},
+					{
+						ExternalId: "department",
+						Attributes: []*AttributeConfig{
+							{ExternalId: "name", Type: AttributeTypeString},
+						},
+					},
+				},
+			},
+			value: user,
+			wantObject: Object{
+				"id":         "alice",
+				"active":     true,
+				"age":        int64(42),
+				"score":      float64(1.5),
+				"timeout":    Duration{Seconds: 90, Nanos: 5},
+				"objectGUID": UUID{0x01},
+				"emails":     []string{"alice@example.com"},
+				"createdAt":  createdAt,
+				"groups": []Object{
+					{"id": "g1"},
+					{"id": "g2"},
+				},
+				"department": []Object{
+					{"name": "Sales"},
+				},
+			},
+		},
+		"only_requested_attributes": {
+			entity: &EntityConfig{
+				ExternalId: "users",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+				},
+			},
+			value: *user,
+			wantObject: Object{
+				"id": "alice",
+			},
+		},
+		"nil_embedded_struct": {
+			entity: &EntityConfig{
+				ExternalId: "users",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+					{ExternalId: "createdAt", Type: AttributeTypeDateTime},
+				},
+			},
+			value: &testUser{ID: "bob"},
+			wantObject: Object{
+				"id": "bob",
+			},
+		},
+		"mismatched_type": {
+			entity: &EntityConfig{
+				ExternalId: "users",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "age", Type: AttributeTypeString},
+				},
+			},
+			value:   user,
+			wantErr: errors.New("field framework.testUser.Age cannot be mapped to attribute age: type int32 does not match type string (list=false)"),
+		},
+		"mismatched_list": {
+			entity: &EntityConfig{
+				ExternalId: "users",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString, List: true},
+				},
+			},
+			value:   user,
+			wantErr: errors.New("field framework.testUser.ID cannot be mapped to attribute id: type string does not match type string (list=true)"),
+		},
+		"not_a_struct": {
+			entity:  &EntityConfig{ExternalId: "users"},
+			value:   "alice",
+			wantErr: errors.New("cannot convert a value of type string into an object: not a struct"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObject, gotErr := ObjectFromStruct(tt.entity, tt.value)
+
+			if tt.wantErr != nil {
+				if gotErr == nil || gotErr.Error() != tt.wantErr.Error() {
+					t.Fatalf("Expected error %v, got %v", tt.wantErr, gotErr)
+				}
+
+				return
+			}
+
+			if gotErr != nil {
+				t.Fatalf("Unexpected error: %v", gotErr)
+			}
+
+			if !reflect.DeepEqual(tt.wantObject, gotObject) {
+				t.Errorf("Expected %#v, got %#v", tt.wantObject, gotObject)
+			}
+		})
+	}
+}
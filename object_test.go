@@ -157,3 +157,213 @@ func TestAddChildObjects_SameName(t *testing.T) {
 		t.Errorf("Unexpected error: %v", err)
 	}
 }
+
+func TestSplitChildObjects(t *testing.T) {
+	members := []Object{{"id": "m1"}, {"id": "m2"}, {"id": "m3"}}
+
+	tests := map[string]struct {
+		object     Object
+		maxObjects int64
+		wantObject Object
+		wantErr    error
+	}{
+		"split": {
+			object:     Object{"id": "g1", "members": members},
+			maxObjects: 2,
+			wantObject: Object{
+				"id": "g1",
+				"members": ChildObjects{
+					Objects:    members[:2],
+					NextCursor: "2",
+				},
+			},
+		},
+		"not_split": {
+			object:     Object{"id": "g1", "members": members},
+			maxObjects: 3,
+			wantObject: Object{"id": "g1", "members": members},
+		},
+		"no_child_objects": {
+			object:     Object{"id": "g1"},
+			maxObjects: 2,
+			wantObject: Object{"id": "g1"},
+		},
+		"attribute": {
+			object:     Object{"members": "m1"},
+			maxObjects: 2,
+			wantErr:    errors.New("attribute already exists with that external ID in object: members"),
+		},
+		"already_split": {
+			object:     Object{"members": ChildObjects{Objects: members, NextCursor: "abc"}},
+			maxObjects: 2,
+			wantErr:    errors.New("child objects already have a next cursor in object: members"),
+		},
+		"invalid_max_objects": {
+			object:     Object{"members": members},
+			maxObjects: 0,
+			wantErr:    errors.New("invalid maximum number of child objects: 0"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := SplitChildObjects(tc.object, "members", tc.maxObjects)
+
+			if !reflect.DeepEqual(tc.wantErr, err) {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr == nil && !reflect.DeepEqual(tc.wantObject, tc.object) {
+				t.Errorf("Expected %#v, got %#v", tc.wantObject, tc.object)
+			}
+		})
+	}
+}
+
+func TestSetChildObjectsCursor(t *testing.T) {
+	object := Object{"id": "g1"}
+
+	if err := AddChildObjects(object, "members", Object{"id": "m1"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := SetChildObjectsCursor(object, "members", "next"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := AddChildObjects(object, "members", Object{"id": "m2"}); err != nil { // Append after setting the cursor
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	wantObject := Object{
+		"id": "g1",
+		"members": ChildObjects{
+			Objects:    []Object{{"id": "m1"}, {"id": "m2"}},
+			NextCursor: "next",
+		},
+	}
+
+	if !reflect.DeepEqual(wantObject, object) {
+		t.Errorf("Expected %#v, got %#v", wantObject, object)
+	}
+
+	err := SetChildObjectsCursor(object, "id", "next")
+	wantErr := errors.New("attribute already exists with that external ID in object: id")
+	if err == nil || wantErr.Error() != err.Error() {
+		t.Errorf("Expected %#v, got %#v", wantErr, err)
+	}
+}
+
+func TestNewChildPageObject(t *testing.T) {
+	entity := &EntityConfig{
+		ExternalId: "groups",
+		Attributes: []*AttributeConfig{
+			{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+			{ExternalId: "name", Type: AttributeTypeString},
+		},
+		ChildEntities: []*EntityConfig{
+			{
+				ExternalId: "members",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+				},
+			},
+		},
+	}
+
+	members := []Object{{"id": "m1"}, {"id": "m2"}, {"id": "m3"}}
+	parent := Object{"id": "g1", "name": "Group 1", "members": members}
+
+	tests := map[string]struct {
+		parent     Object
+		childPage  *ChildPageRequest
+		pageSize   int64
+		wantObject Object
+		wantErr    error
+	}{
+		"first_page": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1"},
+			pageSize:  2,
+			wantObject: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: members[:2], NextCursor: "2"},
+			},
+		},
+		"middle_page": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1", Cursor: "1"},
+			pageSize:  1,
+			wantObject: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: members[1:2], NextCursor: "2"},
+			},
+		},
+		"last_page": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1", Cursor: "2"},
+			pageSize:  2,
+			wantObject: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: members[2:]},
+			},
+		},
+		"cursor_past_end": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1", Cursor: "10"},
+			pageSize:  2,
+			wantObject: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: []Object{}},
+			},
+		},
+		"split_parent": {
+			parent: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: members},
+			},
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1", Cursor: "1"},
+			pageSize:  5,
+			wantObject: Object{
+				"id":      "g1",
+				"members": ChildObjects{Objects: members[1:]},
+			},
+		},
+		"invalid_child_entity": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "owners", ParentID: "g1"},
+			pageSize:  2,
+			wantErr:   errors.New("entity owners is not a child entity of entity groups"),
+		},
+		"invalid_page_size": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1"},
+			pageSize:  0,
+			wantErr:   errors.New("invalid page size: 0"),
+		},
+		"no_unique_id": {
+			parent:    Object{"name": "Group 1", "members": members},
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1"},
+			pageSize:  2,
+			wantErr:   errors.New("parent object contains no unique ID attribute for entity groups"),
+		},
+		"invalid_cursor": {
+			parent:    parent,
+			childPage: &ChildPageRequest{ChildEntityExternalID: "members", ParentID: "g1", Cursor: "-1"},
+			pageSize:  2,
+			wantErr:   errors.New("invalid child objects cursor: -1"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotObject, gotErr := NewChildPageObject(entity, tc.parent, tc.childPage, tc.pageSize)
+
+			if !reflect.DeepEqual(tc.wantErr, gotErr) {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, gotErr)
+			}
+
+			if !reflect.DeepEqual(tc.wantObject, gotObject) {
+				t.Errorf("Expected %#v, got %#v", tc.wantObject, gotObject)
+			}
+		})
+	}
+}
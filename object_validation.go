@@ -0,0 +1,286 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+const (
+	// ViolationReasonInvalidAttributeExternalID is the reason of a violation
+	// for a value with an external ID that is not the external ID of any
+	// attribute or child entity of the entity.
+	ViolationReasonInvalidAttributeExternalID = "INVALID_ATTRIBUTE_EXTERNAL_ID"
+
+	// ViolationReasonInvalidChildEntityExternalID is the reason of a violation
+	// for child objects with an external ID that is not the external ID of
+	// any child entity of the entity.
+	ViolationReasonInvalidChildEntityExternalID = "INVALID_CHILD_ENTITY_EXTERNAL_ID"
+
+	// ViolationReasonInvalidAttributeType is the reason of a violation for an
+	// attribute value whose type doesn't match the attribute's type and list
+	// flag.
+	ViolationReasonInvalidAttributeType = "INVALID_ATTRIBUTE_TYPE"
+
+	// ViolationReasonNoNonNullAttributes is the reason of a violation for an
+	// object that contains no non-null attribute values.
+	ViolationReasonNoNonNullAttributes = "NO_NON_NULL_ATTRIBUTES"
+)
+
+// ObjectViolation is a violation of the rules that the server applies to the
+// objects returned by an adapter. An object with any violation causes the
+// server to fail the whole page.
+type ObjectViolation struct {
+	// ObjectIndex is the index of the invalid object in the validated list of
+	// objects.
+	ObjectIndex int
+
+	// Path is the path to the invalid attribute or child objects within the
+	// object, e.g. "groups[2].id".
+	// Empty if the violation is about the top object as a whole.
+	Path string
+
+	// Reason is the machine-readable reason of the violation, which is one of
+	// the ViolationReason constants.
+	Reason string
+
+	// Message is the description of the violation.
+	Message string
+}
+
+// Error returns the description of the violation, so that it can be passed as
+// an error, e.g. into NewObjectSkippedWarning.
+func (v ObjectViolation) Error() string {
+	if v.Path == "" {
+		return fmt.Sprintf("object %d: %s", v.ObjectIndex, v.Message)
+	}
+
+	return fmt.Sprintf("object %d: %s: %s", v.ObjectIndex, v.Path, v.Message)
+}
+
+// ValidateObjects validates the given objects for the given entity, applying
+// the same rules as the server applies when converting a page:
+//   - Each key must be the external ID of an attribute or, if its value is a
+//     []Object or a ChildObjects, of a child entity.
+//   - The type of each attribute value must match the attribute's type and
+//     list flag.
+//   - Each object and child object must contain at least one non-null
+//     attribute value.
+//
+// Returns all the violations in a deterministic order, grouped by object index.
+// Returns nil if all the objects are valid.
+func ValidateObjects(entity *EntityConfig, objects []Object) (violations []ObjectViolation) {
+	for i, object := range objects {
+		violations = validateObject(entity, object, i, "", violations)
+	}
+
+	return violations
+}
+
+// validateObject appends the violations of the given object at the given path
+// within the object at the given index.
+func validateObject(
+	entity *EntityConfig,
+	object Object,
+	objectIndex int,
+	path string,
+	violations []ObjectViolation,
+) []ObjectViolation {
+	newViolation := func(externalId, reason, message string) ObjectViolation {
+		return ObjectViolation{
+			ObjectIndex: objectIndex,
+			Path:        joinObjectPath(path, externalId),
+			Reason:      reason,
+			Message:     message,
+		}
+	}
+
+	// Iterate over the sorted externalIds, in order to always return the
+	// violations in the same order.
+	sortedExternalIds := make([]string, 0, len(object))
+	for externalId := range object {
+		sortedExternalIds = append(sortedExternalIds, externalId)
+	}
+	sort.Strings(sortedExternalIds)
+
+	hasNonNullAttribute := false
+
+	for _, externalId := range sortedExternalIds {
+		value := object[externalId]
+
+		switch value.(type) {
+		case []Object, ChildObjects: // Child objects for a child entity.
+			childEntity := getChildEntityConfig(entity, externalId)
+			if childEntity == nil {
+				violations = append(violations, newViolation(externalId, ViolationReasonInvalidChildEntityExternalID,
+					fmt.Sprintf("child objects with an invalid entity external ID: %s", externalId)))
+
+				continue
+			}
+
+			// The value is always valid child objects in this case.
+			childObjects, _, _ := getChildObjects(object, externalId)
+
+			for i, childObject := range childObjects.Objects {
+				violations = validateObject(childEntity, childObject, objectIndex,
+					fmt.Sprintf("%s[%d]", joinObjectPath(path, externalId), i), violations)
+			}
+
+		default: // Attribute.
+			attribute := getAttributeConfig(entity, externalId)
+			if attribute == nil {
+				violations = append(violations, newViolation(externalId, ViolationReasonInvalidAttributeExternalID,
+					fmt.Sprintf("attribute with an invalid external ID: %s", externalId)))
+
+				continue
+			}
+
+			if !IsValidAttributeValue(attribute.Type, attribute.List, value) {
+				violations = append(violations, newViolation(externalId, ViolationReasonInvalidAttributeType,
+					fmt.Sprintf("value with invalid type %T for attribute %s with type %s (list=%t)",
+						value, externalId, getAttributeTypeName(attribute.Type), attribute.List)))
+
+				continue
+			}
+
+			if !isNullAttributeValue(value) {
+				hasNonNullAttribute = true
+			}
+		}
+	}
+
+	if !hasNonNullAttribute {
+		violations = append(violations, ObjectViolation{
+			ObjectIndex: objectIndex,
+			Path:        path,
+			Reason:      ViolationReasonNoNonNullAttributes,
+			Message:     fmt.Sprintf("object for entity %s contains no non-null attributes", entity.ExternalId),
+		})
+	}
+
+	return violations
+}
+
+// joinObjectPath returns the path to the given external ID within the object
+// at the given path.
+func joinObjectPath(path, externalId string) string {
+	if path == "" {
+		return externalId
+	}
+
+	return path + "." + externalId
+}
+
+// getAttributeConfig returns the config of the attribute of the given entity
+// with the given external ID, or nil if not found.
+func getAttributeConfig(entity *EntityConfig, externalId string) *AttributeConfig {
+	for _, attribute := range entity.Attributes {
+		if attribute.ExternalId == externalId {
+			return attribute
+		}
+	}
+
+	return nil
+}
+
+// getChildEntityConfig returns the config of the child entity of the given
+// entity with the given external ID, or nil if not found.
+func getChildEntityConfig(entity *EntityConfig, externalId string) *EntityConfig {
+	for _, childEntity := range entity.ChildEntities {
+		if childEntity.ExternalId == externalId {
+			return childEntity
+		}
+	}
+
+	return nil
+}
+
+// IsValidAttributeValue returns true if the type of the given value is valid
+// for an attribute with the given type and list flag, i.e. if it is one of
+// the types of AttributeValue for that type and list flag, a pointer to the
+// type of its (non-bytes) elements, or an empty []any for a list.
+// Nil values are always valid.
+//
+// These are the rules the server applies when converting a page.
+func IsValidAttributeValue(attributeType AttributeType, list bool, value any) bool {
+	var (
+		valueType AttributeType
+		valueList bool
+	)
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []any:
+		// Only empty lists of unknown type are accepted.
+		return len(v) == 0 && list
+	case []bool, []*bool:
+		valueType, valueList = AttributeTypeBool, true
+	case []time.Time, []*time.Time:
+		valueType, valueList = AttributeTypeDateTime, true
+	case []Duration, []*Duration:
+		valueType, valueList = AttributeTypeDuration, true
+	case []float64, []*float64:
+		valueType, valueList = AttributeTypeDouble, true
+	case []int64, []*int64:
+		valueType, valueList = AttributeTypeInt64, true
+	case []string, []*string:
+		valueType, valueList = AttributeTypeString, true
+	case [][]byte:
+		valueType, valueList = AttributeTypeBytes, true
+	case []UUID, []*UUID:
+		valueType, valueList = AttributeTypeUUID, true
+	case bool, *bool:
+		valueType = AttributeTypeBool
+	case time.Time, *time.Time:
+		valueType = AttributeTypeDateTime
+	case Duration, *Duration:
+		valueType = AttributeTypeDuration
+	case float64, *float64:
+		valueType = AttributeTypeDouble
+	case int64, *int64:
+		valueType = AttributeTypeInt64
+	case string, *string:
+		valueType = AttributeTypeString
+	case []byte:
+		valueType = AttributeTypeBytes
+	case UUID, *UUID:
+		valueType = AttributeTypeUUID
+	default:
+		return false
+	}
+
+	return valueType == attributeType && valueList == list
+}
+
+// isNullAttributeValue returns true if the given valid attribute value is
+// equivalent to null, i.e. nil, a nil pointer, or a nil slice.
+func isNullAttributeValue(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
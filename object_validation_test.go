@@ -0,0 +1,210 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateObjects(t *testing.T) {
+	entity := &EntityConfig{
+		ExternalId: "users",
+		Attributes: []*AttributeConfig{
+			{ExternalId: "id", Type: AttributeTypeString, UniqueId: true},
+			{ExternalId: "age", Type: AttributeTypeInt64},
+			{ExternalId: "emails", Type: AttributeTypeString, List: true},
+		},
+		ChildEntities: []*EntityConfig{
+			{
+				ExternalId: "groups",
+				Attributes: []*AttributeConfig{
+					{ExternalId: "id", Type: AttributeTypeString},
+				},
+			},
+		},
+	}
+
+	var nilString *string
+
+	tests := map[string]struct {
+		objects        []Object
+		wantViolations []ObjectViolation
+	}{
+		"valid": {
+			objects: []Object{
+				{"id": "alice", "age": int64(42), "emails": []string{}},
+				{"id": "bob", "emails": []any{}, "groups": []Object{{"id": "g1"}}},
+				{"emails": []*string{nil}, "groups": []Object{}},
+				{"id": "carol", "groups": ChildObjects{Objects: []Object{{"id": "g1"}}, NextCursor: "1"}},
+			},
+		},
+		"invalid_attribute_external_id": {
+			objects: []Object{
+				{"id": "alice"},
+				{"id": "bob", "name": "Bob"},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 1,
+					Path:        "name",
+					Reason:      ViolationReasonInvalidAttributeExternalID,
+					Message:     "attribute with an invalid external ID: name",
+				},
+			},
+		},
+		"invalid_child_entity_external_id": {
+			objects: []Object{
+				{"id": "alice", "roles": []Object{{"id": "r1"}}},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 0,
+					Path:        "roles",
+					Reason:      ViolationReasonInvalidChildEntityExternalID,
+					Message:     "child objects with an invalid entity external ID: roles",
+				},
+			},
+		},
+		"invalid_attribute_types": {
+			objects: []Object{
+				{"id": "alice", "age": 42, "emails": "alice@example.com"},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 0,
+					Path:        "age",
+					Reason:      ViolationReasonInvalidAttributeType,
+					Message:     "value with invalid type int for attribute age with type int64 (list=false)",
+				},
+				{
+					ObjectIndex: 0,
+					Path:        "emails",
+					Reason:      ViolationReasonInvalidAttributeType,
+					Message:     "value with invalid type string for attribute emails with type string (list=true)",
+				},
+			},
+		},
+		"no_non_null_attributes": {
+			objects: []Object{
+				{"id": nilString, "emails": []string(nil)},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 0,
+					Reason:      ViolationReasonNoNonNullAttributes,
+					Message:     "object for entity users contains no non-null attributes",
+				},
+			},
+		},
+		"invalid_child_objects": {
+			objects: []Object{
+				{"id": "alice"},
+				{"id": "bob", "groups": []Object{{"id": "g1"}, {"id": int64(2)}}},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 1,
+					Path:        "groups[1].id",
+					Reason:      ViolationReasonInvalidAttributeType,
+					Message:     "value with invalid type int64 for attribute id with type string (list=false)",
+				},
+				{
+					ObjectIndex: 1,
+					Path:        "groups[1]",
+					Reason:      ViolationReasonNoNonNullAttributes,
+					Message:     "object for entity groups contains no non-null attributes",
+				},
+			},
+		},
+		"invalid_paged_child_objects": {
+			objects: []Object{
+				{"id": "alice", "groups": ChildObjects{Objects: []Object{{"id": int64(1)}}, NextCursor: "1"}},
+			},
+			wantViolations: []ObjectViolation{
+				{
+					ObjectIndex: 0,
+					Path:        "groups[0].id",
+					Reason:      ViolationReasonInvalidAttributeType,
+					Message:     "value with invalid type int64 for attribute id with type string (list=false)",
+				},
+				{
+					ObjectIndex: 0,
+					Path:        "groups[0]",
+					Reason:      ViolationReasonNoNonNullAttributes,
+					Message:     "object for entity groups contains no non-null attributes",
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotViolations := ValidateObjects(entity, tt.objects)
+
+			if !reflect.DeepEqual(tt.wantViolations, gotViolations) {
+				t.Errorf("Expected %#v, got %#v", tt.wantViolations, gotViolations)
+			}
+		})
+	}
+}
+
+func TestObjectViolation_Error(t *testing.T) {
+	violation := ObjectViolation{
+		ObjectIndex: 3,
+		Path:        "groups[1].id",
+		Reason:      ViolationReasonInvalidAttributeType,
+		Message:     "value with invalid type int64 for attribute id with type string (list=false)",
+	}
+
+	want := "object 3: groups[1].id: value with invalid type int64 for attribute id with type string (list=false)"
+	if got := violation.Error(); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestIsValidAttributeValue(t *testing.T) {
+	var nilBytes *[]byte
+
+	tests := map[string]struct {
+		attributeType AttributeType
+		list          bool
+		value         any
+		want          bool
+	}{
+		"nil":                 {AttributeTypeString, false, nil, true},
+		"string":              {AttributeTypeString, false, "a", true},
+		"string_pointer":      {AttributeTypeString, false, new(string), true},
+		"string_list":         {AttributeTypeString, true, []string{"a"}, true},
+		"string_pointer_list": {AttributeTypeString, true, []*string{nil}, true},
+		"string_for_list":     {AttributeTypeString, true, "a", false},
+		"list_for_string":     {AttributeTypeString, false, []string{"a"}, false},
+		"mismatched_type":     {AttributeTypeInt64, false, "a", false},
+		"empty_any_list":      {AttributeTypeInt64, true, []any{}, true},
+		"non_empty_any_list":  {AttributeTypeInt64, true, []any{int64(1)}, false},
+		"bytes":               {AttributeTypeBytes, false, []byte("a"), true},
+		"bytes_pointer":       {AttributeTypeBytes, false, nilBytes, false},
+		"uuid":                {AttributeTypeUUID, false, UUID{}, true},
+		"unsupported_type":    {AttributeTypeInt64, false, 1, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsValidAttributeValue(tc.attributeType, tc.list, tc.value); got != tc.want {
+				t.Errorf("Expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,476 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
+)
+
+// ProtoAdapter is a lower-level alternative to Adapter for high-volume
+// adapters, which writes the objects of a page directly as RPC objects into a
+// PageBuilder, instead of returning Objects that are then converted.
+//
+// The Config type parameter must be a struct type the configuration
+// JSON object can be unmarshaled into.
+type ProtoAdapter[Config any] interface {
+	// GetPage writes a page of objects from the requested datasource for the
+	// requested entity into the given PageBuilder.
+	// The returned Response contains the page's next cursor and warnings, or an
+	// error. It must not contain any objects.
+	GetPage(ctx context.Context, request *Request[Config], page *PageBuilder) Response
+}
+
+// EntityMapping maps the external IDs of the requested attributes and child
+// entities of an entity to their IDs and types.
+type EntityMapping struct {
+	id            string
+	externalId    string
+	attributes    map[string]*AttributeRef
+	childEntities map[string]*EntityMapping
+}
+
+// AttributeRef is a reference to a requested attribute of an entity, used to
+// write the attribute's values into an ObjectBuilder.
+type AttributeRef struct {
+	entity     *EntityMapping
+	id         string
+	externalId string
+	typ        AttributeType
+	list       bool
+}
+
+// newEntityMapping returns the mapping of the given entity config, which must
+// have already been validated.
+func newEntityMapping(entity *api_adapter_v1.EntityConfig) *EntityMapping {
+	m := &EntityMapping{
+		id:         entity.Id,
+		externalId: entity.ExternalId,
+		attributes: make(map[string]*AttributeRef, len(entity.Attributes)),
+	}
+
+	for _, attribute := range entity.Attributes {
+		m.attributes[attribute.ExternalId] = &AttributeRef{
+			entity:     m,
+			id:         attribute.Id,
+			externalId: attribute.ExternalId,
+			typ:        AttributeType(attribute.Type),
+			list:       attribute.List,
+		}
+	}
+
+	if len(entity.ChildEntities) > 0 {
+		m.childEntities = make(map[string]*EntityMapping, len(entity.ChildEntities))
+
+		for _, childEntity := range entity.ChildEntities {
+			m.childEntities[childEntity.ExternalId] = newEntityMapping(childEntity)
+		}
+	}
+
+	return m
+}
+
+// ID returns the ID of the entity.
+func (m *EntityMapping) ID() string {
+	return m.id
+}
+
+// ExternalID returns the external ID of the entity.
+func (m *EntityMapping) ExternalID() string {
+	return m.externalId
+}
+
+// Attribute returns the reference to the attribute with the given external ID,
+// or nil if the attribute is not requested.
+// Writing values with a nil AttributeRef is a no-op, so that adapters can write
+// all the attributes they know of and let the unrequested ones be dropped.
+func (m *EntityMapping) Attribute(externalID string) *AttributeRef {
+	return m.attributes[externalID]
+}
+
+// ChildEntity returns the mapping of the child entity with the given external
+// ID, or nil if the child entity is not requested.
+func (m *EntityMapping) ChildEntity(externalID string) *EntityMapping {
+	return m.childEntities[externalID]
+}
+
+// ID returns the ID of the attribute.
+func (r *AttributeRef) ID() string {
+	return r.id
+}
+
+// ExternalID returns the external ID of the attribute.
+func (r *AttributeRef) ExternalID() string {
+	return r.externalId
+}
+
+// Type returns the type of the attribute's values.
+func (r *AttributeRef) Type() AttributeType {
+	return r.typ
+}
+
+// List returns true if the attribute contains a list of values.
+func (r *AttributeRef) List() bool {
+	return r.list
+}
+
+// PageBuilder builds the RPC objects of a page for a ProtoAdapter.
+//
+// Values are validated against the type and list flag of their attribute as
+// they are written. The first invalid write is recorded and makes all further
+// writes no-ops, and is returned by Err and Build.
+//
+// A PageBuilder is not safe for concurrent use.
+type PageBuilder struct {
+	entity  *EntityMapping
+	objects []*api_adapter_v1.Object
+	err     error
+	alloc   rpcvalue.Allocator
+}
+
+// NewPageBuilder returns a PageBuilder for the given entity config, which must
+// have already been validated. It is called by the server for each request,
+// and may be called in tests.
+func NewPageBuilder(entity *api_adapter_v1.EntityConfig) *PageBuilder {
+	return &PageBuilder{
+		entity: newEntityMapping(entity),
+	}
+}
+
+// Entity returns the mapping of the requested entity.
+func (b *PageBuilder) Entity() *EntityMapping {
+	return b.entity
+}
+
+// AddObject adds an object for the requested entity into the page and returns
+// the builder to write its values.
+func (b *PageBuilder) AddObject() ObjectBuilder {
+	object := b.entity.newObject(&b.alloc)
+	b.objects = append(b.objects, object)
+
+	return ObjectBuilder{
+		page:   b,
+		entity: b.entity,
+		object: object,
+	}
+}
+
+// newObject returns a new RPC object for the entity, with room for all its
+// attributes.
+func (e *EntityMapping) newObject(alloc *rpcvalue.Allocator) *api_adapter_v1.Object {
+	object := alloc.NewObject()
+	object.Attributes = alloc.NewAttributeList(len(e.attributes))
+
+	return object
+}
+
+// Len returns the number of objects added into the page.
+func (b *PageBuilder) Len() int {
+	return len(b.objects)
+}
+
+// Err returns the first error that occurred while writing values, if any.
+func (b *PageBuilder) Err() error {
+	return b.err
+}
+
+// Build returns the objects of the page.
+// Returns an error if an invalid value was written, or if an object or child
+// object contains no non-null attributes.
+func (b *PageBuilder) Build() ([]*api_adapter_v1.Object, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if err := validateBuiltObjects(b.entity, b.objects); err != nil {
+		return nil, err
+	}
+
+	return b.objects, nil
+}
+
+// validateBuiltObjects returns an error if an object or child object of the
+// given entity contains no non-null attributes.
+func validateBuiltObjects(entity *EntityMapping, objects []*api_adapter_v1.Object) error {
+	for _, object := range objects {
+		if len(object.Attributes) == 0 {
+			return fmt.Errorf("object for entity %s contains no non-null attributes", entity.externalId)
+		}
+
+		for _, childObjects := range object.ChildObjects {
+			for _, childEntity := range entity.childEntities {
+				if childEntity.id != childObjects.EntityId {
+					continue
+				}
+
+				if err := validateBuiltObjects(childEntity, childObjects.Objects); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ObjectBuilder writes the values of an object added into a PageBuilder.
+// All the methods of the zero ObjectBuilder are no-ops.
+//
+// Each attribute must be written at most once per object: writing it again is
+// an invalid write, recorded like a type mismatch. Null values, i.e.
+// nil lists and nil byte slices, are not written, since omitting an attribute
+// is equivalent to returning null.
+type ObjectBuilder struct {
+	page   *PageBuilder
+	entity *EntityMapping
+	object *api_adapter_v1.Object
+}
+
+// AddChildObject adds a child object for the given child entity into the
+// object and returns the builder to write its values.
+// Returns the zero ObjectBuilder if childEntity is nil, i.e. if the child
+// entity is not requested.
+func (o ObjectBuilder) AddChildObject(childEntity *EntityMapping) ObjectBuilder {
+	childObjects := o.getChildObjects(childEntity)
+	if childObjects == nil {
+		return ObjectBuilder{}
+	}
+
+	childObject := childEntity.newObject(&o.page.alloc)
+	childObjects.Objects = append(childObjects.Objects, childObject)
+
+	return ObjectBuilder{
+		page:   o.page,
+		entity: childEntity,
+		object: childObject,
+	}
+}
+
+// SetChildObjectsCursor sets the cursor of the next page of child objects of
+// the given child entity in the object, i.e. indicates that the child objects
+// added into the object are not all the child objects of that entity.
+// No-op if childEntity is nil, i.e. if the child entity is not requested.
+func (o ObjectBuilder) SetChildObjectsCursor(childEntity *EntityMapping, nextCursor string) {
+	if childObjects := o.getChildObjects(childEntity); childObjects != nil {
+		childObjects.NextCursor = nextCursor
+	}
+}
+
+// getChildObjects returns the child objects of the given child entity in the
+// object, which are added into the object if not found.
+// Returns nil if childEntity is nil or is not a child entity of the object's
+// entity.
+func (o ObjectBuilder) getChildObjects(childEntity *EntityMapping) *api_adapter_v1.EntityObjects {
+	if o.object == nil || childEntity == nil || o.page.err != nil {
+		return nil
+	}
+
+	if o.entity.childEntities[childEntity.externalId] != childEntity {
+		o.page.err = fmt.Errorf("entity %s is not a child entity of entity %s", childEntity.externalId, o.entity.externalId)
+
+		return nil
+	}
+
+	for _, entityObjects := range o.object.ChildObjects {
+		if entityObjects.EntityId == childEntity.id {
+			return entityObjects
+		}
+	}
+
+	childObjects := &api_adapter_v1.EntityObjects{EntityId: childEntity.id}
+	o.object.ChildObjects = append(o.object.ChildObjects, childObjects)
+
+	return childObjects
+}
+
+// SetBool writes the value of a bool attribute.
+func (o ObjectBuilder) SetBool(attribute *AttributeRef, value bool) {
+	setValue(o, attribute, AttributeTypeBool, value, (*rpcvalue.Allocator).NewBoolValue)
+}
+
+// SetBoolList writes the values of a bool list attribute.
+func (o ObjectBuilder) SetBoolList(attribute *AttributeRef, values []bool) {
+	setListValue(o, attribute, AttributeTypeBool, values, (*rpcvalue.Allocator).NewBoolValue)
+}
+
+// SetDateTime writes the value of a date-time attribute.
+func (o ObjectBuilder) SetDateTime(attribute *AttributeRef, value time.Time) {
+	setValue(o, attribute, AttributeTypeDateTime, value, (*rpcvalue.Allocator).NewDateTimeValue)
+}
+
+// SetDateTimeList writes the values of a date-time list attribute.
+func (o ObjectBuilder) SetDateTimeList(attribute *AttributeRef, values []time.Time) {
+	setListValue(o, attribute, AttributeTypeDateTime, values, (*rpcvalue.Allocator).NewDateTimeValue)
+}
+
+// SetDouble writes the value of a double attribute.
+func (o ObjectBuilder) SetDouble(attribute *AttributeRef, value float64) {
+	setValue(o, attribute, AttributeTypeDouble, value, (*rpcvalue.Allocator).NewDoubleValue)
+}
+
+// SetDoubleList writes the values of a double list attribute.
+func (o ObjectBuilder) SetDoubleList(attribute *AttributeRef, values []float64) {
+	setListValue(o, attribute, AttributeTypeDouble, values, (*rpcvalue.Allocator).NewDoubleValue)
+}
+
+// SetDuration writes the value of a duration attribute.
+func (o ObjectBuilder) SetDuration(attribute *AttributeRef, value Duration) {
+	setValue(o, attribute, AttributeTypeDuration, value, newDurationValue)
+}
+
+// SetDurationList writes the values of a duration list attribute.
+func (o ObjectBuilder) SetDurationList(attribute *AttributeRef, values []Duration) {
+	setListValue(o, attribute, AttributeTypeDuration, values, newDurationValue)
+}
+
+// SetInt64 writes the value of an int64 attribute.
+func (o ObjectBuilder) SetInt64(attribute *AttributeRef, value int64) {
+	setValue(o, attribute, AttributeTypeInt64, value, (*rpcvalue.Allocator).NewInt64Value)
+}
+
+// SetInt64List writes the values of an int64 list attribute.
+func (o ObjectBuilder) SetInt64List(attribute *AttributeRef, values []int64) {
+	setListValue(o, attribute, AttributeTypeInt64, values, (*rpcvalue.Allocator).NewInt64Value)
+}
+
+// SetString writes the value of a string attribute.
+func (o ObjectBuilder) SetString(attribute *AttributeRef, value string) {
+	setValue(o, attribute, AttributeTypeString, value, (*rpcvalue.Allocator).NewStringValue)
+}
+
+// SetStringList writes the values of a string list attribute.
+func (o ObjectBuilder) SetStringList(attribute *AttributeRef, values []string) {
+	setListValue(o, attribute, AttributeTypeString, values, (*rpcvalue.Allocator).NewStringValue)
+}
+
+// SetBytes writes the value of a bytes attribute.
+// A nil value is null and is not written.
+func (o ObjectBuilder) SetBytes(attribute *AttributeRef, value []byte) {
+	if value == nil {
+		return
+	}
+
+	setValue(o, attribute, AttributeTypeBytes, value, (*rpcvalue.Allocator).NewBytesValue)
+}
+
+// SetBytesList writes the values of a bytes list attribute.
+// Nil elements are written as null values.
+func (o ObjectBuilder) SetBytesList(attribute *AttributeRef, values [][]byte) {
+	setListValue(o, attribute, AttributeTypeBytes, values, (*rpcvalue.Allocator).NewBytesValue)
+}
+
+// SetUUID writes the value of a UUID attribute.
+func (o ObjectBuilder) SetUUID(attribute *AttributeRef, value UUID) {
+	setValue(o, attribute, AttributeTypeUUID, value, newUUIDValue)
+}
+
+// SetUUIDList writes the values of a UUID list attribute.
+func (o ObjectBuilder) SetUUIDList(attribute *AttributeRef, values []UUID) {
+	setListValue(o, attribute, AttributeTypeUUID, values, newUUIDValue)
+}
+
+// setValue writes the value of a non-list attribute of the given type.
+func setValue[Value any](
+	o ObjectBuilder,
+	attribute *AttributeRef,
+	attributeType AttributeType,
+	value Value,
+	newValue func(*rpcvalue.Allocator, Value) *api_adapter_v1.AttributeValue,
+) {
+	if !o.checkAttribute(attribute, attributeType, false) {
+		return
+	}
+
+	alloc := &o.page.alloc
+
+	values := alloc.NewValueList(1)
+	values[0] = newValue(alloc, value)
+
+	o.object.Attributes = append(o.object.Attributes, alloc.NewAttribute(attribute.id, values))
+}
+
+// setListValue writes the values of a list attribute of the given type.
+func setListValue[Value any](
+	o ObjectBuilder,
+	attribute *AttributeRef,
+	attributeType AttributeType,
+	values []Value,
+	newValue func(*rpcvalue.Allocator, Value) *api_adapter_v1.AttributeValue,
+) {
+	if values == nil || !o.checkAttribute(attribute, attributeType, true) {
+		return
+	}
+
+	alloc := &o.page.alloc
+
+	rpcValues := alloc.NewValueList(len(values))
+	for i, value := range values {
+		rpcValues[i] = newValue(alloc, value)
+	}
+
+	o.object.Attributes = append(o.object.Attributes, alloc.NewAttribute(attribute.id, rpcValues))
+}
+
+// checkAttribute returns true if values of the given type and list flag can be
+// written for the given attribute. Otherwise, records an error if the write is
+// invalid.
+func (o ObjectBuilder) checkAttribute(attribute *AttributeRef, attributeType AttributeType, list bool) bool {
+	if o.object == nil || attribute == nil || o.page.err != nil {
+		return false
+	}
+
+	switch {
+	case attribute.entity != o.entity:
+		o.page.err = fmt.Errorf("attribute %s is not an attribute of entity %s", attribute.externalId, o.entity.externalId)
+	case attribute.typ != attributeType || attribute.list != list:
+		o.page.err = fmt.Errorf("value with type %s (list=%t) written for attribute %s with type %s (list=%t)",
+			getAttributeTypeName(attributeType), list, attribute.externalId, getAttributeTypeName(attribute.typ), attribute.list)
+	case o.hasAttribute(attribute):
+		o.page.err = fmt.Errorf("attribute %s written more than once for an object of entity %s", attribute.externalId, o.entity.externalId)
+	default:
+		return true
+	}
+
+	return false
+}
+
+// hasAttribute returns true if values have already been written for the given
+// attribute into the object.
+// Objects contain few attributes, so scanning them is cheaper than tracking
+// the written attributes in a set allocated for each object.
+func (o ObjectBuilder) hasAttribute(attribute *AttributeRef) bool {
+	for _, written := range o.object.Attributes {
+		if written.Id == attribute.id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newDurationValue returns a new RPC duration value allocated from the given
+// allocator.
+func newDurationValue(alloc *rpcvalue.Allocator, v Duration) *api_adapter_v1.AttributeValue {
+	return alloc.NewDurationValue(v.Nanos, v.Seconds, v.Days, v.Months)
+}
+
+// newUUIDValue returns a new RPC UUID value allocated from the given allocator.
+func newUUIDValue(alloc *rpcvalue.Allocator, v UUID) *api_adapter_v1.AttributeValue {
+	return alloc.NewUUIDValue(v.String())
+}
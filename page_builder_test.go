@@ -0,0 +1,162 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
+)
+
+func TestPageBuilder(t *testing.T) {
+	alloc := new(rpcvalue.Allocator)
+
+	entity := &api_adapter_v1.EntityConfig{
+		Id:         "entity-1",
+		ExternalId: "groups",
+		Attributes: []*api_adapter_v1.AttributeConfig{
+			{Id: "attr-1", ExternalId: "id", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, UniqueId: true},
+			{Id: "attr-2", ExternalId: "size", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
+			{Id: "attr-3", ExternalId: "member", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
+		},
+		ChildEntities: []*api_adapter_v1.EntityConfig{
+			{
+				Id:         "entity-2",
+				ExternalId: "owners",
+				Attributes: []*api_adapter_v1.AttributeConfig{
+					{Id: "attr-4", ExternalId: "id", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		write       func(page *PageBuilder)
+		wantObjects []*api_adapter_v1.Object
+		wantErr     error
+	}{
+		"valid": {
+			write: func(page *PageBuilder) {
+				id := page.Entity().Attribute("id")
+				size := page.Entity().Attribute("size")
+				member := page.Entity().Attribute("member")
+				description := page.Entity().Attribute("description") // Not requested.
+				owners := page.Entity().ChildEntity("owners")
+				ownerID := owners.Attribute("id")
+
+				object := page.AddObject()
+				object.SetString(id, "group-1")
+				object.SetInt64(size, 2)
+				object.SetStringList(member, []string{"alice", "bob"})
+				object.SetString(description, "ignored")
+				object.AddChildObject(owners).SetString(ownerID, "alice")
+				object.AddChildObject(owners).SetString(ownerID, "carol")
+				object.AddChildObject(page.Entity().ChildEntity("admins")).SetString(ownerID, "ignored")
+
+				object = page.AddObject()
+				object.SetString(id, "group-2")
+				object.SetStringList(member, nil)
+				object.SetChildObjectsCursor(owners, "owners-page-2")
+				object.SetChildObjectsCursor(page.Entity().ChildEntity("admins"), "ignored")
+			},
+			wantObjects: []*api_adapter_v1.Object{
+				{
+					Attributes: []*api_adapter_v1.Attribute{
+						{Id: "attr-1", Values: []*api_adapter_v1.AttributeValue{alloc.NewStringValue("group-1")}},
+						{Id: "attr-2", Values: []*api_adapter_v1.AttributeValue{alloc.NewInt64Value(2)}},
+						{Id: "attr-3", Values: []*api_adapter_v1.AttributeValue{alloc.NewStringValue("alice"), alloc.NewStringValue("bob")}},
+					},
+					ChildObjects: []*api_adapter_v1.EntityObjects{
+						{
+							EntityId: "entity-2",
+							Objects: []*api_adapter_v1.Object{
+								{Attributes: []*api_adapter_v1.Attribute{{Id: "attr-4", Values: []*api_adapter_v1.AttributeValue{alloc.NewStringValue("alice")}}}},
+								{Attributes: []*api_adapter_v1.Attribute{{Id: "attr-4", Values: []*api_adapter_v1.AttributeValue{alloc.NewStringValue("carol")}}}},
+							},
+						},
+					},
+				},
+				{
+					Attributes: []*api_adapter_v1.Attribute{
+						{Id: "attr-1", Values: []*api_adapter_v1.AttributeValue{alloc.NewStringValue("group-2")}},
+					},
+					ChildObjects: []*api_adapter_v1.EntityObjects{
+						{
+							EntityId:   "entity-2",
+							NextCursor: "owners-page-2",
+						},
+					},
+				},
+			},
+		},
+		"mismatched_type": {
+			write: func(page *PageBuilder) {
+				object := page.AddObject()
+				object.SetString(page.Entity().Attribute("size"), "2")
+				object.SetString(page.Entity().Attribute("id"), "group-1")
+			},
+			wantErr: errors.New("value with type string (list=false) written for attribute size with type int64 (list=false)"),
+		},
+		"mismatched_list": {
+			write: func(page *PageBuilder) {
+				page.AddObject().SetString(page.Entity().Attribute("member"), "alice")
+			},
+			wantErr: errors.New("value with type string (list=false) written for attribute member with type string (list=true)"),
+		},
+		"attribute_of_other_entity": {
+			write: func(page *PageBuilder) {
+				page.AddObject().SetString(page.Entity().ChildEntity("owners").Attribute("id"), "alice")
+			},
+			wantErr: errors.New("attribute id is not an attribute of entity groups"),
+		},
+		"duplicate_attribute": {
+			write: func(page *PageBuilder) {
+				object := page.AddObject()
+				object.SetString(page.Entity().Attribute("id"), "group-1")
+				object.SetStringList(page.Entity().Attribute("member"), []string{"alice"})
+				object.SetString(page.Entity().Attribute("id"), "group-2")
+			},
+			wantErr: errors.New("attribute id written more than once for an object of entity groups"),
+		},
+		"no_non_null_attributes": {
+			write: func(page *PageBuilder) {
+				object := page.AddObject()
+				object.SetString(page.Entity().Attribute("id"), "group-1")
+				object.AddChildObject(page.Entity().ChildEntity("owners"))
+			},
+			wantErr: errors.New("object for entity owners contains no non-null attributes"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			page := NewPageBuilder(entity)
+			tt.write(page)
+
+			gotObjects, gotErr := page.Build()
+
+			if !reflect.DeepEqual(tt.wantErr, gotErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, gotErr)
+			}
+
+			if !reflect.DeepEqual(tt.wantObjects, gotObjects) {
+				t.Errorf("Expected %v, got %v", tt.wantObjects, gotObjects)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+type TestAuth struct {
+	ClientID     string `json:"clientId" validate:"required"`
+	ClientSecret string `json:"clientSecret" validate:"required" secret:"true"`
+}
+
+type TestBase struct {
+	APIVersion string `json:"apiVersion" default:"v1" validate:"enum=v1|v2"`
+}
+
+type TestConfig struct {
+	TestBase
+
+	BaseURL   string            `json:"baseUrl" validate:"required,url"`
+	PageSize  int               `json:"pageSize" default:"100" validate:"min=1,max=1000"`
+	Timeout   time.Duration     `json:"timeout" default:"30s"`
+	Interval  string            `json:"interval" validate:"duration"`
+	Scopes    []string          `json:"scopes" validate:"max=2"`
+	Auth      *TestAuth         `json:"auth"`
+	Headers   map[string]string `json:"headers"`
+	Ignored   string            `json:"-"`
+	unexposed string
+}
+
+type TestValidatedConfig struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+func (c *TestValidatedConfig) Validate() error {
+	if c.Min > c.Max {
+		return errors.New("min must not be greater than max")
+	}
+
+	return nil
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		data       string
+		strict     bool
+		wantConfig *TestConfig
+		wantErr    string
+	}{
+		"defaults": {
+			data: `{"baseUrl":"https://example.com"}`,
+			wantConfig: &TestConfig{
+				TestBase: TestBase{APIVersion: "v1"},
+				BaseURL:  "https://example.com",
+				PageSize: 100,
+				Timeout:  30 * time.Second,
+			},
+		},
+		"valid": {
+			data: `{"apiVersion":"v2","baseUrl":"https://example.com/api","pageSize":10,"timeout":5000000000,` +
+				`"interval":"PT1H","scopes":["a"],"auth":{"clientId":"id","clientSecret":"secret"}}`,
+			wantConfig: &TestConfig{
+				TestBase: TestBase{APIVersion: "v2"},
+				BaseURL:  "https://example.com/api",
+				PageSize: 10,
+				Timeout:  5 * time.Second,
+				Interval: "PT1H",
+				Scopes:   []string{"a"},
+				Auth:     &TestAuth{ClientID: "id", ClientSecret: "secret"},
+			},
+		},
+		"invalid_values": {
+			data: `{"apiVersion":"v3","baseUrl":"example.com","pageSize":1001,"interval":"1 hour",` +
+				`"scopes":["a","b","c"],"auth":{"clientId":"id"}}`,
+			wantErr: `$.apiVersion: must be one of [v1 v2]; $.auth.clientSecret: is required; ` +
+				`$.baseUrl: must be an absolute URL; $.interval: must be a duration; ` +
+				`$.pageSize: must be at most 1000; $.scopes: must be at most 2 elements`,
+		},
+		"missing_required": {
+			data:    `{"pageSize":-1}`,
+			wantErr: `$.baseUrl: is required; $.pageSize: must be at least 1`,
+		},
+		"invalid_type": {
+			data:    `{"baseUrl":"https://example.com","auth":{"clientId":1}}`,
+			wantErr: `$.auth.clientId: cannot be a JSON number`,
+		},
+		"invalid_json": {
+			data:    `{invalid`,
+			wantErr: `invalid character 'i' looking for beginning of object key string`,
+		},
+		"unknown_fields_ignored": {
+			data: `{"baseUrl":"https://example.com","pagesize":5,"unknown":true}`,
+			wantConfig: &TestConfig{
+				TestBase: TestBase{APIVersion: "v1"},
+				BaseURL:  "https://example.com",
+				PageSize: 5,
+				Timeout:  30 * time.Second,
+			},
+		},
+		"strict_unknown_fields": {
+			data:    `{"baseUrl":"https://example.com","unknown":true,"auth":{"clientID":"id","clientSecret":"s","scope":"x"},"Ignored":"a"}`,
+			strict:  true,
+			wantErr: `$.Ignored: unknown field; $.auth.scope: unknown field; $.unknown: unknown field`,
+		},
+		"strict_known_fields": {
+			data:   `{"apiVersion":"v1","baseUrl":"https://example.com","headers":{"X-Key":"a"}}`,
+			strict: true,
+			wantConfig: &TestConfig{
+				TestBase: TestBase{APIVersion: "v1"},
+				BaseURL:  "https://example.com",
+				PageSize: 100,
+				Timeout:  30 * time.Second,
+				Headers:  map[string]string{"X-Key": "a"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var opts []config.Option
+			if tc.strict {
+				opts = append(opts, config.Strict())
+			}
+
+			gotConfig, err := config.Parse[TestConfig]([]byte(tc.data), opts...)
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != tc.wantErr {
+				t.Errorf("gotErr: %q, wantErr: %q", gotErr, tc.wantErr)
+			}
+
+			if !reflect.DeepEqual(gotConfig, tc.wantConfig) {
+				t.Errorf("gotConfig: %+v, wantConfig: %+v", gotConfig, tc.wantConfig)
+			}
+		})
+	}
+}
+
+func TestParse_Nil(t *testing.T) {
+	gotConfig, err := config.Parse[TestConfig](nil)
+	if gotConfig != nil || err != nil {
+		t.Errorf("gotConfig: %v, gotErr: %v, want nil", gotConfig, err)
+	}
+}
+
+func TestParse_Validator(t *testing.T) {
+	_, err := config.Parse[TestValidatedConfig]([]byte(`{"min":2,"max":1}`))
+
+	var validationErrs config.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("gotErr: %v, want ValidationErrors", err)
+	}
+
+	if got, want := err.Error(), "$: min must not be greater than max"; got != want {
+		t.Errorf("gotErr: %q, wantErr: %q", got, want)
+	}
+
+	if _, err := config.Parse[TestValidatedConfig]([]byte(`{"min":1,"max":2}`)); err != nil {
+		t.Errorf("gotErr: %v, want nil", err)
+	}
+}
+
+func TestApplyDefaults_InvalidDefault(t *testing.T) {
+	type InvalidDefaultConfig struct {
+		PageSize int `json:"pageSize" default:"many"`
+	}
+
+	err := config.ApplyDefaults(&InvalidDefaultConfig{})
+	if err == nil {
+		t.Fatal("gotErr: nil, want error")
+	}
+
+	if got, want := err.Error(), "invalid default value for $.pageSize: invalid character 'm' looking for beginning of value"; got != want {
+		t.Errorf("gotErr: %q, wantErr: %q", got, want)
+	}
+}
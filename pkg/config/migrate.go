@@ -0,0 +1,96 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// VersionField is the name of the top-level field containing the version of
+// a config. Configs without this field are at version 0.
+const VersionField = "version"
+
+// Migration upgrades a decoded config JSON object from a version to the next,
+// in place. Numbers in the config are decoded as json.Number.
+// The version field is updated after the migration returns.
+type Migration func(config map[string]any) error
+
+// Migrations are the ordered migrations of configs: the migration at index i
+// upgrades configs from version i to version i+1.
+// The latest version of configs is the number of migrations.
+type Migrations []Migration
+
+// Version returns the latest version of configs.
+func (m Migrations) Version() int64 {
+	return int64(len(m))
+}
+
+// Migrate upgrades the given marshaled JSON config object to the latest
+// version, and returns the migrated config and the version of the given
+// config.
+// If the config is already at the latest version, it is returned unchanged.
+// Returns an error if the config's version is invalid or more recent than
+// the latest version, or if a migration fails.
+func (m Migrations) Migrate(data []byte) (migrated []byte, version int64, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var config map[string]any
+	if err := decoder.Decode(&config); err != nil {
+		return nil, 0, err
+	}
+
+	if config == nil {
+		return nil, 0, errors.New("config is not a JSON object")
+	}
+
+	if rawVersion, found := config[VersionField]; found {
+		number, ok := rawVersion.(json.Number)
+		if !ok {
+			return nil, 0, fmt.Errorf("%s field is not a number", VersionField)
+		}
+
+		if version, err = number.Int64(); err != nil || version < 0 {
+			return nil, 0, fmt.Errorf("%s field is not a valid version: %s", VersionField, number)
+		}
+	}
+
+	latest := m.Version()
+
+	switch {
+	case version == latest:
+		return data, version, nil
+	case version > latest:
+		return nil, version, fmt.Errorf("config version %d is more recent than the latest supported version %d", version, latest)
+	}
+
+	for v := version; v < latest; v++ {
+		if err := m[v](config); err != nil {
+			return nil, version, fmt.Errorf("failed to migrate config from version %d to %d: %w", v, v+1, err)
+		}
+
+		config[VersionField] = v + 1
+	}
+
+	migrated, err = json.Marshal(config)
+	if err != nil {
+		return nil, version, err
+	}
+
+	return migrated, version, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+func TestMigrations_Migrate(t *testing.T) {
+	migrations := config.Migrations{
+		// Version 1 moved "url" into "baseUrl".
+		func(c map[string]any) error {
+			c["baseUrl"] = c["url"]
+			delete(c, "url")
+
+			return nil
+		},
+		// Version 2 converted "timeoutSeconds" into "timeout" nanoseconds.
+		func(c map[string]any) error {
+			seconds, ok := c["timeoutSeconds"].(json.Number)
+			if !ok {
+				return errors.New("timeoutSeconds is not a number")
+			}
+
+			n, err := seconds.Int64()
+			if err != nil {
+				return err
+			}
+
+			c["timeout"] = n * 1e9
+			delete(c, "timeoutSeconds")
+
+			return nil
+		},
+	}
+
+	tests := map[string]struct {
+		data        string
+		want        string
+		wantVersion int64
+		wantErr     string
+	}{
+		"version_0": {
+			data: `{"url":"https://example.com","timeoutSeconds":30,"big":12345678901234567890}`,
+			want: `{"baseUrl":"https://example.com","big":12345678901234567890,"timeout":30000000000,"version":2}`,
+		},
+		"version_1": {
+			data:        `{"baseUrl":"https://example.com","timeoutSeconds":5,"version":1}`,
+			want:        `{"baseUrl":"https://example.com","timeout":5000000000,"version":2}`,
+			wantVersion: 1,
+		},
+		"latest_version_unchanged": {
+			data:        `{"baseUrl": "https://example.com", "version": 2}`,
+			want:        `{"baseUrl": "https://example.com", "version": 2}`,
+			wantVersion: 2,
+		},
+		"future_version": {
+			data:        `{"version":3}`,
+			wantVersion: 3,
+			wantErr:     "config version 3 is more recent than the latest supported version 2",
+		},
+		"invalid_version": {
+			data:    `{"version":"1"}`,
+			wantErr: "version field is not a number",
+		},
+		"negative_version": {
+			data:    `{"version":-1}`,
+			wantErr: "version field is not a valid version: -1",
+		},
+		"not_object": {
+			data:    `null`,
+			wantErr: "config is not a JSON object",
+		},
+		"migration_error": {
+			data:        `{"timeoutSeconds":"30","version":1}`,
+			wantVersion: 1,
+			wantErr:     "failed to migrate config from version 1 to 2: timeoutSeconds is not a number",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, gotVersion, err := migrations.Migrate([]byte(tc.data))
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != tc.wantErr {
+				t.Errorf("gotErr: %q, wantErr: %q", gotErr, tc.wantErr)
+			}
+
+			if string(got) != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+
+			if gotVersion != tc.wantVersion {
+				t.Errorf("gotVersion: %d, wantVersion: %d", gotVersion, tc.wantVersion)
+			}
+		})
+	}
+}
+
+func TestParse_StrictAcceptsVersion(t *testing.T) {
+	type VersionlessConfig struct {
+		A string `json:"a"`
+	}
+
+	if _, err := config.Parse[VersionlessConfig]([]byte(`{"a":"a","version":2}`), config.Strict()); err != nil {
+		t.Errorf("gotErr: %v, want nil", err)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Validator may be implemented by Config types to validate a config after it
+// is decoded and after its struct tags are validated.
+type Validator interface {
+	// Validate returns an error if the config is invalid.
+	Validate() error
+}
+
+// ValidationError is an invalid value in a config.
+type ValidationError struct {
+	// Path is the JSONPath of the invalid value, e.g. "$.auth.clientId".
+	Path string
+
+	// Message describes why the value is invalid.
+	Message string
+}
+
+// Error returns the path and message of the error.
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is the list of all the invalid values in a config.
+type ValidationErrors []*ValidationError
+
+// Error returns the errors separated by semicolons.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// sort sorts the errors by path, since they may be collected from JSON
+// objects or Go maps in random order.
+func (e ValidationErrors) sort() {
+	slices.SortStableFunc(e, func(a, b *ValidationError) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+}
+
+// options configures how configs are parsed.
+type options struct {
+	// strict indicates whether unknown fields are rejected.
+	strict bool
+}
+
+// Option configures how configs are parsed.
+type Option func(*options)
+
+// Strict rejects configs containing fields that are unknown in the Config
+// type. The top-level version field used by Migrations is always accepted.
+func Strict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// Parse parses a config from the given marshaled JSON object into a new
+// Config, sets the default values of its zero-valued fields, and validates it
+// against its struct tags and its Validate method if Config implements
+// Validator.
+//
+// Returns nil if data is nil.
+// Returns a ValidationErrors if the config is invalid.
+func Parse[Config any](data []byte, opts ...Option) (*Config, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	config := new(Config)
+
+	if err := json.Unmarshal(data, config); err != nil {
+		if typeErr := (*json.UnmarshalTypeError)(nil); errors.As(err, &typeErr) && typeErr.Field != "" {
+			return nil, ValidationErrors{{
+				Path:    "$." + typeErr.Field,
+				Message: fmt.Sprintf("cannot be a JSON %s", typeErr.Value),
+			}}
+		}
+
+		return nil, err
+	}
+
+	if o.strict {
+		var raw any
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+
+		var errs ValidationErrors
+		if object, ok := raw.(map[string]any); ok {
+			delete(object, VersionField)
+		}
+
+		checkUnknownFields(reflect.TypeOf(config).Elem(), raw, "$", &errs)
+
+		if len(errs) > 0 {
+			errs.sort()
+
+			return nil, errs
+		}
+	}
+
+	if err := ApplyDefaults(config); err != nil {
+		return nil, err
+	}
+
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// checkUnknownFields appends an error into errs for every field in the given
+// decoded JSON value that has no corresponding field in the given type.
+func checkUnknownFields(t reflect.Type, value any, path string, errs *ValidationErrors) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		fields := make(map[string]reflect.Type)
+		collectFieldTypes(t, fields)
+
+		for name, fieldValue := range object {
+			fieldType, found := fields[name]
+			if !found {
+				// Like encoding/json, match field names case-insensitively.
+				for fieldName, ft := range fields {
+					if strings.EqualFold(fieldName, name) {
+						fieldType, found = ft, true
+
+						break
+					}
+				}
+			}
+
+			if !found {
+				*errs = append(*errs, &ValidationError{Path: path + "." + name, Message: "unknown field"})
+
+				continue
+			}
+
+			checkUnknownFields(fieldType, fieldValue, path+"."+name, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		list, ok := value.([]any)
+		if !ok {
+			return
+		}
+
+		for i, element := range list {
+			checkUnknownFields(t.Elem(), element, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, element := range object {
+			checkUnknownFields(t.Elem(), element, path+"."+key, errs)
+		}
+	}
+}
+
+// collectFieldTypes maps the JSON names of the fields of the given struct
+// type, including fields promoted from embedded structs, to their types.
+func collectFieldTypes(t reflect.Type, out map[string]reflect.Type) {
+	for _, field := range structFields(t) {
+		fieldType := t.Field(field.index).Type
+
+		if field.embedded {
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			collectFieldTypes(fieldType, out)
+
+			continue
+		}
+
+		out[field.name] = fieldType
+	}
+}
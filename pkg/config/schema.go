@@ -0,0 +1,298 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// SchemaVersion is the URI of the JSON Schema dialect of generated schemas.
+const SchemaVersion = "https://json-schema.org/draft/2020-12/schema"
+
+// timeType is the type of time.Time fields, which are marshaled as RFC 3339
+// strings.
+var timeType = reflect.TypeOf(time.Time{})
+
+// Schema is a JSON Schema describing configs.
+// Only the keywords generated from Config types are supported.
+type Schema struct {
+	Schema      string          `json:"$schema,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Format      string          `json:"format,omitempty"`
+	Enum        []any           `json:"enum,omitempty"`
+	Default     json.RawMessage `json:"default,omitempty"`
+
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	MinItems  *int     `json:"minItems,omitempty"`
+	MaxItems  *int     `json:"maxItems,omitempty"`
+
+	// MinProperties and MaxProperties apply to maps.
+	MinProperties *int `json:"minProperties,omitempty"`
+	MaxProperties *int `json:"maxProperties,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+
+	// WriteOnly and Secret are set on fields tagged with secret:"true".
+	// Secret values should be masked when displayed and never read back.
+	WriteOnly bool `json:"writeOnly,omitempty"`
+	Secret    bool `json:"x-secret,omitempty"`
+}
+
+// JSONSchema returns the JSON Schema of the configs of the given Config type.
+// Returns an error if the struct tags of Config contain invalid default or
+// enum values.
+func JSONSchema[Config any]() (*Schema, error) {
+	return SchemaOf(reflect.TypeFor[Config]())
+}
+
+// SchemaOf returns the JSON Schema of the configs of the given type.
+// Returns an error if the struct tags of the type contain invalid default or
+// enum values.
+func SchemaOf(t reflect.Type) (*Schema, error) {
+	g := schemaGenerator{visiting: make(map[reflect.Type]bool)}
+
+	schema, err := g.schema(t, "$")
+	if err != nil {
+		return nil, err
+	}
+
+	schema.Schema = SchemaVersion
+
+	return schema, nil
+}
+
+// schemaGenerator generates the JSON Schema of a type.
+type schemaGenerator struct {
+	// visiting contains the struct types being generated, to stop at
+	// recursive types.
+	visiting map[reflect.Type]bool
+}
+
+// schema returns the JSON Schema of the given type.
+// path is the JSONPath of the values of the type, used in error messages.
+func (g *schemaGenerator) schema(t reflect.Type, path string) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == durationType:
+		return &Schema{Type: "integer", Description: "Duration in nanoseconds."}, nil
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are marshaled as base64 strings.
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+
+		items, err := g.schema(t.Elem(), path+"[*]")
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := g.schema(t.Elem(), path+".*")
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		if g.visiting[t] {
+			return &Schema{Type: "object"}, nil
+		}
+
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+		if err := g.addProperties(schema, t, path); err != nil {
+			return nil, err
+		}
+
+		return schema, nil
+	default:
+		// Interfaces may contain any JSON value.
+		return &Schema{}, nil
+	}
+}
+
+// addProperties adds the schemas of the fields of the given struct type into
+// the properties of the given schema, including fields promoted from embedded
+// structs.
+func (g *schemaGenerator) addProperties(schema *Schema, t reflect.Type, path string) error {
+	for _, field := range structFields(t) {
+		fieldType := t.Field(field.index).Type
+
+		if field.embedded {
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if err := g.addProperties(schema, fieldType, path); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fieldPath := path + "." + field.name
+
+		property, err := g.schema(fieldType, fieldPath)
+		if err != nil {
+			return err
+		}
+
+		if err := setFieldKeywords(property, fieldType, field); err != nil {
+			return fmt.Errorf("invalid struct tags for %s: %w", fieldPath, err)
+		}
+
+		schema.Properties[field.name] = property
+
+		if field.rules.required {
+			schema.Required = append(schema.Required, field.name)
+		}
+	}
+
+	return nil
+}
+
+// setFieldKeywords sets the keywords of the given schema of a field from the
+// struct tags of the field.
+func setFieldKeywords(schema *Schema, t reflect.Type, field fieldInfo) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if field.description != "" {
+		schema.Description = field.description
+	}
+
+	if field.secret {
+		schema.WriteOnly = true
+		schema.Secret = true
+	}
+
+	if field.hasDefault {
+		v := reflect.New(t).Elem()
+		if err := setDefault(v, field.defaultValue); err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+
+		defaultValue, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+
+		schema.Default = defaultValue
+	}
+
+	for _, value := range field.rules.enum {
+		if t.Kind() == reflect.String {
+			schema.Enum = append(schema.Enum, value)
+
+			continue
+		}
+
+		var enumValue any
+		if err := json.Unmarshal([]byte(value), &enumValue); err != nil {
+			return fmt.Errorf("invalid enum value %s: %w", strconv.Quote(value), err)
+		}
+
+		schema.Enum = append(schema.Enum, enumValue)
+	}
+
+	if field.rules.url {
+		schema.Format = "uri"
+	}
+
+	if field.rules.duration {
+		schema.Format = "duration"
+	}
+
+	setBound(schema, t, field.rules.min, true)
+	setBound(schema, t, field.rules.max, false)
+
+	return nil
+}
+
+// setBound sets the keyword corresponding to a min or max validation rule in
+// the given schema of a field of the given type.
+func setBound(schema *Schema, t reflect.Type, bound *float64, isMin bool) {
+	if bound == nil {
+		return
+	}
+
+	size := int(*bound)
+
+	var numberKeyword **float64
+	var sizeKeyword **int
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		numberKeyword = choose(isMin, &schema.Minimum, &schema.Maximum)
+	case reflect.String:
+		sizeKeyword = choose(isMin, &schema.MinLength, &schema.MaxLength)
+	case reflect.Slice, reflect.Array:
+		sizeKeyword = choose(isMin, &schema.MinItems, &schema.MaxItems)
+	case reflect.Map:
+		sizeKeyword = choose(isMin, &schema.MinProperties, &schema.MaxProperties)
+	}
+
+	if numberKeyword != nil {
+		*numberKeyword = bound
+	}
+
+	if sizeKeyword != nil {
+		*sizeKeyword = &size
+	}
+}
+
+// choose returns a if cond is true, or b otherwise.
+func choose[T any](cond bool, a, b T) T {
+	if cond {
+		return a
+	}
+
+	return b
+}
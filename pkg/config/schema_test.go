@@ -0,0 +1,150 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+type TestDescribedConfig struct {
+	Mode     string            `json:"mode" description:"The sync mode." default:"full" validate:"enum=full|incremental"`
+	Level    int               `json:"level" validate:"enum=1|2"`
+	Password string            `json:"password" validate:"required,min=8" secret:"true"`
+	Data     []byte            `json:"data"`
+	Labels   map[string]string `json:"labels" validate:"max=3"`
+	Children []TestNode        `json:"children"`
+	Any      any               `json:"any"`
+}
+
+type TestNode struct {
+	Name     string     `json:"name"`
+	Children []TestNode `json:"children"`
+}
+
+func TestJSONSchema(t *testing.T) {
+	tests := map[string]struct {
+		gen     func() (*config.Schema, error)
+		want    string
+		wantErr string
+	}{
+		"tags": {
+			gen: config.JSONSchema[TestConfig],
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"properties": {
+					"apiVersion": {"type": "string", "enum": ["v1", "v2"], "default": "v1"},
+					"auth": {
+						"type": "object",
+						"properties": {
+							"clientId": {"type": "string"},
+							"clientSecret": {"type": "string", "writeOnly": true, "x-secret": true}
+						},
+						"required": ["clientId", "clientSecret"]
+					},
+					"baseUrl": {"type": "string", "format": "uri"},
+					"headers": {"type": "object", "additionalProperties": {"type": "string"}},
+					"interval": {"type": "string", "format": "duration"},
+					"pageSize": {"type": "integer", "default": 100, "minimum": 1, "maximum": 1000},
+					"scopes": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+					"timeout": {"type": "integer", "description": "Duration in nanoseconds.", "default": 30000000000}
+				},
+				"required": ["baseUrl"]
+			}`,
+		},
+		"descriptions_and_recursion": {
+			gen: config.JSONSchema[TestDescribedConfig],
+			want: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"type": "object",
+				"properties": {
+					"any": {},
+					"children": {
+						"type": "array",
+						"items": {
+							"type": "object",
+							"properties": {
+								"children": {"type": "array", "items": {"type": "object"}},
+								"name": {"type": "string"}
+							}
+						}
+					},
+					"data": {"type": "string", "format": "byte"},
+					"labels": {"type": "object", "maxProperties": 3, "additionalProperties": {"type": "string"}},
+					"level": {"type": "integer", "enum": [1, 2]},
+					"mode": {"type": "string", "description": "The sync mode.", "enum": ["full", "incremental"], "default": "full"},
+					"password": {"type": "string", "minLength": 8, "writeOnly": true, "x-secret": true}
+				},
+				"required": ["password"]
+			}`,
+		},
+		"invalid_enum": {
+			gen: func() (*config.Schema, error) {
+				type InvalidEnumConfig struct {
+					Level int `json:"level" validate:"enum=one|two"`
+				}
+
+				return config.JSONSchema[InvalidEnumConfig]()
+			},
+			wantErr: `invalid struct tags for $.level: invalid enum value "one": invalid character 'o' looking for beginning of value`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			schema, err := tc.gen()
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != tc.wantErr {
+				t.Fatalf("gotErr: %q, wantErr: %q", gotErr, tc.wantErr)
+			}
+
+			if tc.wantErr != "" {
+				return
+			}
+
+			got, err := json.Marshal(schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Compare the unmarshaled values, since the order of the keywords
+			// in the expected schemas is not significant.
+			var gotValue, wantValue any
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(tc.want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+
+			gotJSON, _ := json.Marshal(gotValue)
+			wantJSON, _ := json.Marshal(wantValue)
+
+			if !bytes.Equal(gotJSON, wantJSON) {
+				t.Errorf("got: %s, want: %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config parses, validates and describes the datasource configs of
+// adapters, based on the struct tags of the adapters' Config types.
+//
+// The following struct tags are supported on the fields of Config types, in
+// addition to the standard json tag which determines the name of each field:
+//
+//	validate:"<rule>,<rule>,..."
+//
+// where each rule is one of:
+//   - required: the value must not be the zero value of its type.
+//   - min=<n>: numbers must be at least n, and strings, slices and maps must
+//     contain at least n characters or elements.
+//   - max=<n>: numbers must be at most n, and strings, slices and maps must
+//     contain at most n characters or elements.
+//   - enum=<a>|<b>|...: the value must be one of the given values.
+//   - url: the value must be an absolute URL.
+//   - duration: the value must be a Go duration (e.g. "1m30s") or an ISO 8601
+//     duration (e.g. "PT1M30S").
+//
+// Rules other than required are ignored for zero values.
+//
+//	default:"<value>"
+//
+// sets the value of the field if it is the zero value after decoding.
+// The default value of string fields is the raw tag value, the default value
+// of time.Duration fields is a Go duration, and the default value of fields
+// of any other type is a JSON value.
+//
+//	description:"<text>"
+//	secret:"true"
+//
+// are only used to generate JSON Schemas.
+package config
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Struct tag names.
+const (
+	TagDefault     = "default"
+	TagDescription = "description"
+	TagSecret      = "secret"
+	TagValidate    = "validate"
+)
+
+// fieldInfo describes a field of a Config struct type.
+type fieldInfo struct {
+	// index is the index of the field in its struct type.
+	index int
+
+	// name is the name of the field in JSON.
+	name string
+
+	// embedded indicates whether the field is an embedded struct which
+	// fields are promoted into the parent JSON object.
+	embedded bool
+
+	// rules are the parsed validation rules of the field.
+	rules rules
+
+	// defaultValue is the default value of the field, if hasDefault.
+	defaultValue string
+	hasDefault   bool
+
+	// description is the description of the field.
+	description string
+
+	// secret indicates whether the field contains a secret.
+	secret bool
+}
+
+// rules are the validation rules of a field.
+type rules struct {
+	required bool
+	min, max *float64
+	enum     []string
+	url      bool
+	duration bool
+}
+
+// structFields returns the fields of the given struct type which are
+// marshaled into JSON.
+func structFields(t reflect.Type) []fieldInfo {
+	fields := make([]fieldInfo, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		jsonTag, hasJSONTag := field.Tag.Lookup("json")
+		name, _, _ := strings.Cut(jsonTag, ",")
+
+		if name == "-" && jsonTag == "-" {
+			continue
+		}
+
+		info := fieldInfo{
+			index: i,
+			name:  name,
+		}
+
+		if field.Anonymous && (!hasJSONTag || name == "") {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				info.embedded = true
+				fields = append(fields, info)
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if info.name == "" {
+			info.name = field.Name
+		}
+
+		info.rules = parseRules(field.Tag.Get(TagValidate))
+		info.defaultValue, info.hasDefault = field.Tag.Lookup(TagDefault)
+		info.description = field.Tag.Get(TagDescription)
+		info.secret, _ = strconv.ParseBool(field.Tag.Get(TagSecret))
+
+		fields = append(fields, info)
+	}
+
+	return fields
+}
+
+// parseRules parses the value of a validate struct tag.
+// Unknown rules are ignored.
+func parseRules(tag string) (r rules) {
+	if tag == "" {
+		return
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch name {
+		case "required":
+			r.required = true
+		case "min":
+			if n, err := strconv.ParseFloat(arg, 64); err == nil {
+				r.min = &n
+			}
+		case "max":
+			if n, err := strconv.ParseFloat(arg, 64); err == nil {
+				r.max = &n
+			}
+		case "enum":
+			r.enum = strings.Split(arg, "|")
+		case "url":
+			r.url = true
+		case "duration":
+			r.duration = true
+		}
+	}
+
+	return
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+)
+
+// durationType is the type of time.Duration fields, which default values are
+// Go durations.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ApplyDefaults sets the zero-valued fields of the given config, which must be
+// a pointer to a struct, to the values of their default struct tags,
+// recursively.
+func ApplyDefaults(config any) error {
+	return applyDefaults(reflect.ValueOf(config), "$")
+}
+
+// applyDefaults sets the default values of the fields of the given value.
+func applyDefaults(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return applyDefaults(v.Elem(), path)
+	case reflect.Struct:
+		for _, field := range structFields(v.Type()) {
+			fieldValue := v.Field(field.index)
+
+			if field.embedded {
+				if err := applyDefaults(fieldValue, path); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			fieldPath := path + "." + field.name
+
+			if field.hasDefault && fieldValue.IsZero() && fieldValue.CanSet() {
+				if err := setDefault(fieldValue, field.defaultValue); err != nil {
+					return fmt.Errorf("invalid default value for %s: %w", fieldPath, err)
+				}
+			}
+
+			if err := applyDefaults(fieldValue, fieldPath); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := applyDefaults(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// setDefault sets the given value from the given default struct tag value.
+func setDefault(v reflect.Value, defaultValue string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(defaultValue)
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(defaultValue)
+	default:
+		ptr := reflect.New(v.Type())
+		if err := json.Unmarshal([]byte(defaultValue), ptr.Interface()); err != nil {
+			return err
+		}
+
+		v.Set(ptr.Elem())
+	}
+
+	return nil
+}
+
+// Validate validates the given config, which must be a pointer to a struct,
+// against the validate struct tags of its fields, recursively, then calls
+// its Validate method if it implements Validator.
+// Returns a ValidationErrors containing all the invalid values.
+func Validate(config any) error {
+	var errs ValidationErrors
+
+	validateValue(reflect.ValueOf(config), "$", &errs)
+
+	if len(errs) > 0 {
+		errs.sort()
+
+		return errs
+	}
+
+	if validator, ok := config.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return ValidationErrors{{Path: "$", Message: err.Error()}}
+		}
+	}
+
+	return nil
+}
+
+// validateValue validates the fields of the given value, recursively.
+func validateValue(v reflect.Value, path string, errs *ValidationErrors) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+
+		validateValue(v.Elem(), path, errs)
+	case reflect.Struct:
+		for _, field := range structFields(v.Type()) {
+			fieldValue := v.Field(field.index)
+
+			if field.embedded {
+				validateValue(fieldValue, path, errs)
+
+				continue
+			}
+
+			fieldPath := path + "." + field.name
+
+			if msg := validateField(fieldValue, field.rules); msg != "" {
+				*errs = append(*errs, &ValidationError{Path: fieldPath, Message: msg})
+
+				continue
+			}
+
+			validateValue(fieldValue, fieldPath, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			validateValue(iter.Value(), fmt.Sprintf("%s.%v", path, iter.Key()), errs)
+		}
+	}
+}
+
+// validateField returns a message describing why the given field value does
+// not satisfy the given rules, or "" if it does.
+func validateField(v reflect.Value, r rules) string {
+	if v.IsZero() {
+		if r.required {
+			return "is required"
+		}
+
+		return ""
+	}
+
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+
+		v = v.Elem()
+	}
+
+	var size float64
+	var sizeUnit string
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		size = v.Float()
+	case reflect.String:
+		size, sizeUnit = float64(len([]rune(v.String()))), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size, sizeUnit = float64(v.Len()), " elements"
+	}
+
+	if r.min != nil && size < *r.min {
+		return fmt.Sprintf("must be at least %s%s", strconv.FormatFloat(*r.min, 'f', -1, 64), sizeUnit)
+	}
+
+	if r.max != nil && size > *r.max {
+		return fmt.Sprintf("must be at most %s%s", strconv.FormatFloat(*r.max, 'f', -1, 64), sizeUnit)
+	}
+
+	if len(r.enum) > 0 && !slices.Contains(r.enum, fmt.Sprint(v.Interface())) {
+		return fmt.Sprintf("must be one of %v", r.enum)
+	}
+
+	if r.url || r.duration {
+		if v.Kind() != reflect.String {
+			return "must be a string"
+		}
+
+		s := v.String()
+
+		if r.url {
+			if u, err := url.ParseRequestURI(s); err != nil || u.Scheme == "" || u.Host == "" {
+				return "must be an absolute URL"
+			}
+		}
+
+		if r.duration {
+			if _, err := time.ParseDuration(s); err != nil {
+				if _, err := framework.ParseISO8601Duration(s); err != nil {
+					return "must be a duration"
+				}
+			}
+		}
+	}
+
+	return ""
+}
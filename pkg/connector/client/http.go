@@ -31,6 +31,23 @@ func NewSGNLHTTPClientWithProxy(timeout time.Duration, userAgent string, client
 	}
 }
 
+// ProxyError is returned by Transport when the connector failed to send a
+// proxied request to the datasource.
+type ProxyError struct {
+	// Message is the error returned by the connector.
+	Message string
+}
+
+func (e *ProxyError) Error() string {
+	return e.Message
+}
+
+// ConnectorError returns true, since the connector failed to send the request.
+// Allows framework.ErrorFromGoError to classify the error.
+func (e *ProxyError) ConnectorError() bool {
+	return true
+}
+
 // Transport with proxy client.
 type Transport struct {
 	rt              http.RoundTripper
@@ -111,7 +128,7 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 	if resp.Error != "" {
-		return nil, fmt.Errorf("%s", resp.Error)
+		return nil, &ProxyError{Message: resp.Error}
 	}
 
 	// Convert gRPC response message to the http.Response
@@ -0,0 +1,154 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ldap contains helpers for adapters getting data from LDAP
+// datasources.
+package ldap
+
+import (
+	"fmt"
+	"strings"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	grpc_proxy_v1 "github.com/sgnl-ai/adapter-framework/pkg/grpc_proxy/v1"
+)
+
+// LDAP result codes, cf. https://datatracker.ietf.org/doc/html/rfc4511#appendix-A,
+// and the client-side result codes of github.com/go-ldap/ldap.
+const (
+	ResultSuccess                     = 0
+	ResultOperationsError             = 1
+	ResultProtocolError               = 2
+	ResultTimeLimitExceeded           = 3
+	ResultSizeLimitExceeded           = 4
+	ResultAuthMethodNotSupported      = 7
+	ResultStrongerAuthRequired        = 8
+	ResultAdminLimitExceeded          = 11
+	ResultConfidentialityRequired     = 13
+	ResultNoSuchAttribute             = 16
+	ResultUndefinedAttributeType      = 17
+	ResultNoSuchObject                = 32
+	ResultInvalidDNSyntax             = 34
+	ResultInappropriateAuthentication = 48
+	ResultInvalidCredentials          = 49
+	ResultInsufficientAccessRights    = 50
+	ResultBusy                        = 51
+	ResultUnavailable                 = 52
+	ResultUnwillingToPerform          = 53
+	ResultOther                       = 80
+	ResultNetworkError                = 200
+	ResultFilterCompileError          = 201
+	ResultEmptyPasswordError          = 206
+)
+
+// resultCodeReasons maps LDAP result codes to the reasons of the errors returned
+// for them.
+var resultCodeReasons = map[int]string{
+	ResultOperationsError:             "LDAP_OPERATIONS_ERROR",
+	ResultProtocolError:               "LDAP_PROTOCOL_ERROR",
+	ResultTimeLimitExceeded:           "LDAP_TIME_LIMIT_EXCEEDED",
+	ResultSizeLimitExceeded:           "LDAP_SIZE_LIMIT_EXCEEDED",
+	ResultAuthMethodNotSupported:      "LDAP_AUTH_METHOD_NOT_SUPPORTED",
+	ResultStrongerAuthRequired:        "LDAP_STRONGER_AUTH_REQUIRED",
+	ResultAdminLimitExceeded:          "LDAP_ADMIN_LIMIT_EXCEEDED",
+	ResultConfidentialityRequired:     "LDAP_CONFIDENTIALITY_REQUIRED",
+	ResultNoSuchAttribute:             "LDAP_NO_SUCH_ATTRIBUTE",
+	ResultUndefinedAttributeType:      "LDAP_UNDEFINED_ATTRIBUTE_TYPE",
+	ResultNoSuchObject:                "LDAP_NO_SUCH_OBJECT",
+	ResultInvalidDNSyntax:             "LDAP_INVALID_DN_SYNTAX",
+	ResultInappropriateAuthentication: "LDAP_INAPPROPRIATE_AUTHENTICATION",
+	ResultInvalidCredentials:          "LDAP_INVALID_CREDENTIALS",
+	ResultInsufficientAccessRights:    "LDAP_INSUFFICIENT_ACCESS_RIGHTS",
+	ResultBusy:                        "LDAP_BUSY",
+	ResultUnavailable:                 "LDAP_UNAVAILABLE",
+	ResultUnwillingToPerform:          "LDAP_UNWILLING_TO_PERFORM",
+	ResultOther:                       "LDAP_OTHER",
+	ResultNetworkError:                "LDAP_NETWORK_ERROR",
+	ResultFilterCompileError:          "LDAP_FILTER_COMPILE_ERROR",
+	ResultEmptyPasswordError:          "LDAP_EMPTY_PASSWORD",
+}
+
+// OperationResponseError returns a detailed error if the given LDAP operation
+// response returned by the gRPC proxy indicates that the operation failed, and
+// nil otherwise.
+func OperationResponseError(resp *grpc_proxy_v1.LDAPOperationResponse) *framework.Error {
+	return ResultCodeError(int(resp.GetResultCode()), resp.GetError())
+}
+
+// ResultCodeError returns a detailed error if the given LDAP result code
+// indicates that the LDAP operation failed, and nil otherwise.
+// The given diagnostic message returned by the LDAP server, if any, is
+// included in the error message.
+// The error's UpstreamStatusCode is the given result code.
+func ResultCodeError(resultCode int, diagnosticMessage string) *framework.Error {
+	if resultCode == ResultSuccess {
+		return nil
+	}
+
+	reason, found := resultCodeReasons[resultCode]
+	if !found {
+		reason = fmt.Sprintf("LDAP_RESULT_%d", resultCode)
+	}
+
+	adapterErr := &framework.Error{
+		UpstreamStatusCode: resultCode,
+		Reason:             reason,
+	}
+
+	switch resultCode {
+	case ResultNoSuchObject:
+		adapterErr.Message = "Entity not found in datasource: the LDAP base DN does not exist. Check entity configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND
+	case ResultInvalidDNSyntax, ResultFilterCompileError:
+		adapterErr.Message = "Datasource rejected the LDAP DN or filter. Check entity configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG
+	case ResultNoSuchAttribute, ResultUndefinedAttributeType:
+		adapterErr.Message = "Datasource rejected an unknown LDAP attribute. Check entity configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_UNKNOWN_ATTRIBUTE
+	case ResultAuthMethodNotSupported, ResultStrongerAuthRequired, ResultInappropriateAuthentication,
+		ResultInvalidCredentials:
+		adapterErr.Message = "Failed to authenticate with datasource. Check datasource configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED
+	case ResultInsufficientAccessRights:
+		// The bind succeeded, but the bound DN is not granted access to the
+		// requested entries.
+		adapterErr.Message = "Access to the LDAP entries denied by datasource. Check the permissions granted to the datasource credentials and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS
+	case ResultEmptyPasswordError:
+		adapterErr.Message = "Datasource auth is missing a password. Check datasource configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_AUTH
+	case ResultConfidentialityRequired:
+		adapterErr.Message = "Datasource requires a secure connection. Check datasource configuration details and try again."
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG
+	case ResultTimeLimitExceeded, ResultAdminLimitExceeded, ResultBusy, ResultUnavailable, ResultNetworkError:
+		adapterErr.Message = fmt.Sprintf("Datasource is temporarily unavailable; try again later, returned LDAP result code: %d.", resultCode)
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE
+		adapterErr.Transient = true
+	case ResultUnwillingToPerform, ResultOther:
+		adapterErr.Message = fmt.Sprintf("Datasource failed to perform the LDAP operation, returned LDAP result code: %d.", resultCode)
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED
+	default:
+		// In the case of other result codes, e.g. protocol errors, indicate
+		// the adapter constructed an invalid request.
+		adapterErr.Message = fmt.Sprintf("Datasource rejected LDAP request, returned LDAP result code: %d.", resultCode)
+		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL
+	}
+
+	if diagnosticMessage != "" {
+		adapterErr.Message = fmt.Sprintf("%s LDAP error: %s.", adapterErr.Message, strings.TrimSuffix(diagnosticMessage, "."))
+	}
+
+	return adapterErr
+}
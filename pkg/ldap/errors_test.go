@@ -0,0 +1,95 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"reflect"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	grpc_proxy_v1 "github.com/sgnl-ai/adapter-framework/pkg/grpc_proxy/v1"
+)
+
+func TestOperationResponseError(t *testing.T) {
+	tests := map[string]struct {
+		resp    *grpc_proxy_v1.LDAPOperationResponse
+		wantErr *framework.Error
+	}{
+		"success": {
+			resp:    &grpc_proxy_v1.LDAPOperationResponse{ResultCode: ResultSuccess},
+			wantErr: nil,
+		},
+		"no_such_object": {
+			resp: &grpc_proxy_v1.LDAPOperationResponse{
+				ResultCode: ResultNoSuchObject,
+				Error:      "0000208D: NameErr: DSID-0310028D, problem 2001 (NO_OBJECT).",
+			},
+			wantErr: &framework.Error{
+				Message:            "Entity not found in datasource: the LDAP base DN does not exist. Check entity configuration details and try again. LDAP error: 0000208D: NameErr: DSID-0310028D, problem 2001 (NO_OBJECT).",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+				UpstreamStatusCode: ResultNoSuchObject,
+				Reason:             "LDAP_NO_SUCH_OBJECT",
+			},
+		},
+		"invalid_credentials": {
+			resp: &grpc_proxy_v1.LDAPOperationResponse{ResultCode: ResultInvalidCredentials},
+			wantErr: &framework.Error{
+				Message:            "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				UpstreamStatusCode: ResultInvalidCredentials,
+				Reason:             "LDAP_INVALID_CREDENTIALS",
+			},
+		},
+		"insufficient_access_rights": {
+			resp: &grpc_proxy_v1.LDAPOperationResponse{ResultCode: ResultInsufficientAccessRights},
+			wantErr: &framework.Error{
+				Message:            "Access to the LDAP entries denied by datasource. Check the permissions granted to the datasource credentials and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS,
+				UpstreamStatusCode: ResultInsufficientAccessRights,
+				Reason:             "LDAP_INSUFFICIENT_ACCESS_RIGHTS",
+			},
+		},
+		"busy": {
+			resp: &grpc_proxy_v1.LDAPOperationResponse{ResultCode: ResultBusy},
+			wantErr: &framework.Error{
+				Message:            "Datasource is temporarily unavailable; try again later, returned LDAP result code: 51.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+				UpstreamStatusCode: ResultBusy,
+				Reason:             "LDAP_BUSY",
+				Transient:          true,
+			},
+		},
+		"unknown_result_code": {
+			resp: &grpc_proxy_v1.LDAPOperationResponse{ResultCode: 71},
+			wantErr: &framework.Error{
+				Message:            "Datasource rejected LDAP request, returned LDAP result code: 71.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				UpstreamStatusCode: 71,
+				Reason:             "LDAP_RESULT_71",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := OperationResponseError(tt.resp)
+
+			if !reflect.DeepEqual(tt.wantErr, gotErr) {
+				t.Errorf("Expected %#v, got %#v", tt.wantErr, gotErr)
+			}
+		})
+	}
+}
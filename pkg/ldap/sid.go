@@ -0,0 +1,65 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatSID returns the string form of the given binary security identifier,
+// e.g. "S-1-5-21-3623811015-3361044348-30300820-1013", as stored in the Active
+// Directory objectSid attribute.
+// Cf. https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-dtyp/f992ad60-0fe4-4b87-9fed-beb478836861.
+func FormatSID(b []byte) (string, error) {
+	if len(b) < 8 {
+		return "", fmt.Errorf("invalid SID length: %d bytes", len(b))
+	}
+
+	revision := b[0]
+	subAuthorityCount := int(b[1])
+
+	if len(b) != 8+4*subAuthorityCount {
+		return "", fmt.Errorf("invalid SID length: %d bytes for %d sub-authorities", len(b), subAuthorityCount)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("S-")
+	sb.WriteString(strconv.Itoa(int(revision)))
+	sb.WriteByte('-')
+
+	// The identifier authority is a 48-bit big-endian integer, formatted in
+	// hexadecimal if larger than 32 bits.
+	var authority uint64
+	for _, c := range b[2:8] {
+		authority = authority<<8 | uint64(c)
+	}
+
+	if authority >= 1<<32 {
+		fmt.Fprintf(&sb, "0x%012X", authority)
+	} else {
+		sb.WriteString(strconv.FormatUint(authority, 10))
+	}
+
+	for i := range subAuthorityCount {
+		sb.WriteByte('-')
+		sb.WriteString(strconv.FormatUint(uint64(binary.LittleEndian.Uint32(b[8+4*i:])), 10))
+	}
+
+	return sb.String(), nil
+}
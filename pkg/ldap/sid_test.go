@@ -0,0 +1,67 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ldap
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestFormatSID(t *testing.T) {
+	tests := map[string]struct {
+		sidHex  string
+		want    string
+		wantErr bool
+	}{
+		"domain_user": {
+			sidHex: "010500000000000515000000c7f7fed77c7755c8945ace01f5030000",
+			want:   "S-1-5-21-3623811015-3361044348-30300820-1013",
+		},
+		"well_known_everyone": {
+			sidHex: "010100000000000100000000",
+			want:   "S-1-1-0",
+		},
+		"large_authority": {
+			sidHex: "0100010000000000",
+			want:   "S-1-0x010000000000",
+		},
+		"too_short": {
+			sidHex:  "0105",
+			wantErr: true,
+		},
+		"truncated_sub_authorities": {
+			sidHex:  "01050000000000051500",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := hex.DecodeString(tt.sidHex)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := FormatSID(b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %t, got %v", tt.wantErr, err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -2,21 +2,36 @@ package logs
 
 // Log field constants.
 const (
+	FieldCircuitState           = "circuitState"
 	FieldClientID               = "clientId"
+	FieldConsecutiveFailures    = "consecutiveFailures"
 	FieldDatasourceAddress      = "datasourceAddress"
 	FieldDatasourceID           = "datasourceId"
 	FieldDatasourceType         = "datasourceType"
 	FieldEntityExternalID       = "entityExternalId"
 	FieldEntityID               = "entityId"
+	FieldError                  = "error"
 	FieldAdapterRequestPageSize = "adapterRequestPageSize"
+	FieldPageObjectCount        = "pageObjectCount"
+	FieldPageWarningCounts      = "pageWarningCounts"
 	FieldTenantID               = "tenantId"
 )
 
+// CircuitState returns a log field for the state of a datasource circuit breaker.
+func CircuitState(value string) Field {
+	return Field{Key: FieldCircuitState, Value: value}
+}
+
 // ClientID returns a log field for the client ID.
 func ClientID(value string) Field {
 	return Field{Key: FieldClientID, Value: value}
 }
 
+// ConsecutiveFailures returns a log field for a number of consecutive failed requests.
+func ConsecutiveFailures(value int) Field {
+	return Field{Key: FieldConsecutiveFailures, Value: value}
+}
+
 // DatasourceAddress returns a log field for the datasource address.
 func DatasourceAddress(value string) Field {
 	return Field{Key: FieldDatasourceAddress, Value: value}
@@ -42,6 +57,22 @@ func EntityID(value string) Field {
 	return Field{Key: FieldEntityID, Value: value}
 }
 
+// Error returns a log field for an error.
+func Error(value error) Field {
+	return Field{Key: FieldError, Value: value.Error()}
+}
+
+// PageObjectCount returns a log field for the number of objects in a returned page.
+func PageObjectCount(value int) Field {
+	return Field{Key: FieldPageObjectCount, Value: value}
+}
+
+// PageWarningCounts returns a log field for the number of warnings in a returned
+// page, by warning code.
+func PageWarningCounts(value map[string]int) Field {
+	return Field{Key: FieldPageWarningCounts, Value: value}
+}
+
 // RequestPageSize returns a log field for the request page size.
 func RequestPageSize(value int64) Field {
 	return Field{Key: FieldAdapterRequestPageSize, Value: value}
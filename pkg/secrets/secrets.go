@@ -0,0 +1,366 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets resolves references to secrets in datasource configs.
+//
+// A secret reference is a JSON object with a single "$secret" field which
+// value identifies the secret as "<scheme>:<name>", for instance:
+//
+//	{"clientSecret": {"$secret": "file:/var/run/secrets/client-secret"}}
+//	{"privateKey": {"$secret": "env:ADAPTER_SECRET_PRIVATE_KEY"}}
+//
+// ResolveJSON replaces every secret reference in a JSON document with the
+// string value of the secret, so that adapters get secrets without changing
+// their Config types.
+//
+// Since datasource configs are supplied by tenants, the default resolvers
+// only resolve the files within a configured root directory and the
+// environment variables with a configured name prefix.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// ReferenceKey is the name of the only field of a secret reference object.
+const ReferenceKey = "$secret"
+
+// Resolver resolves secret references into secret values.
+//
+// Implementations must never include secret values in returned errors.
+type Resolver interface {
+	// Resolve returns the value of the secret identified by the given
+	// reference.
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+// SchemeResolver is a Resolver which delegates the resolution of each
+// "<scheme>:<name>" reference to the Resolver registered for the scheme,
+// passing it the name.
+type SchemeResolver map[string]Resolver
+
+// Resolve returns the value of the secret identified by the given reference.
+func (r SchemeResolver) Resolve(ctx context.Context, ref string) (string, error) {
+	scheme, name, found := strings.Cut(ref, ":")
+	if !found {
+		return "", fmt.Errorf("secret reference %q has no scheme", ref)
+	}
+
+	resolver, found := r[scheme]
+	if !found {
+		return "", fmt.Errorf("secret reference %q has an unsupported scheme", ref)
+	}
+
+	return resolver.Resolve(ctx, name)
+}
+
+// EnvResolver is a Resolver which resolves names of environment variables
+// into their values.
+//
+// Only the environment variables which names start with Prefix are resolved,
+// so that datasource configs cannot reference the other environment variables
+// of the process. The zero EnvResolver resolves no environment variables.
+type EnvResolver struct {
+	// Prefix is the required prefix of the names of the environment variables,
+	// e.g. "ADAPTER_SECRET_".
+	Prefix string
+}
+
+// Resolve returns the value of the environment variable with the given name.
+func (r EnvResolver) Resolve(_ context.Context, name string) (string, error) {
+	if r.Prefix == "" || !strings.HasPrefix(name, r.Prefix) {
+		return "", fmt.Errorf("secret environment variable %s does not have the required prefix", name)
+	}
+
+	value, found := os.LookupEnv(name)
+	if !found {
+		return "", fmt.Errorf("secret environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
+
+// FileResolver is a Resolver which resolves file paths into the contents of
+// the files, without any trailing newline.
+//
+// Only the files within the root directory are resolved, after following
+// symbolic links, so that datasource configs cannot reference the other files
+// readable by the process.
+//
+// File contents are cached until the files are modified, so that secrets
+// mounted as files, e.g. Kubernetes secrets, are reloaded when they are
+// rotated.
+type FileResolver struct {
+	// root is the absolute path of the root directory, without symbolic
+	// links.
+	root string
+
+	// rootDir is the root directory, through which files are opened so that
+	// symbolic links replaced after a path is checked cannot escape it.
+	rootDir *os.Root
+
+	watcher *fsnotify.Watcher
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// cache maps the path of each resolved file, without symbolic links, to
+	// the contents of the file.
+	cache map[string]string
+
+	// watchedDirs contains the directories containing resolved files, which
+	// are watched for modifications.
+	watchedDirs map[string]bool
+}
+
+// NewFileResolver returns a FileResolver which resolves the files within the
+// given root directory. Relative paths are resolved relative to root.
+// The stop channel is used to signal when the file watcher and the root
+// directory should be closed.
+func NewFileResolver(root string, stop <-chan struct{}) (*FileResolver, error) {
+	if root == "" {
+		return nil, errors.New("secret files root directory is not set")
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the absolute path of the secret files root directory: %w", err)
+	}
+
+	if root, err = filepath.EvalSymlinks(root); err != nil {
+		return nil, fmt.Errorf("failed to resolve the secret files root directory: %w", err)
+	}
+
+	rootDir, err := os.OpenRoot(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the secret files root directory: %w", err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		rootDir.Close()
+
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	r := &FileResolver{
+		root:        root,
+		rootDir:     rootDir,
+		watcher:     watcher,
+		cache:       make(map[string]string),
+		watchedDirs: make(map[string]bool),
+	}
+
+	go r.watch(stop)
+
+	return r, nil
+}
+
+// Resolve returns the contents of the file at the given path.
+// Returns an error if the file is not within the root directory.
+func (r *FileResolver) Resolve(_ context.Context, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(r.root, path)
+	}
+
+	path = filepath.Clean(path)
+
+	// Files are cached by their path without symbolic links, so that files
+	// replaced by re-linking them, e.g. rotated Kubernetes secrets, are read
+	// again.
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	if !isWithinDir(r.root, realPath) {
+		return "", fmt.Errorf("secret file %s is outside of the secret files root directory", path)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if value, found := r.cache[realPath]; found {
+		return value, nil
+	}
+
+	// Watch the directory rather than the file itself, since secret files
+	// are commonly replaced by renaming them.
+	dir := filepath.Dir(realPath)
+	if !r.watchedDirs[dir] {
+		if err := r.watcher.Add(dir); err != nil {
+			return "", fmt.Errorf("failed to watch secret file %s: %w", path, err)
+		}
+
+		r.watchedDirs[dir] = true
+	}
+
+	relPath, err := filepath.Rel(r.root, realPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	// Read the file through the root directory, which fails if a symbolic
+	// link replaced since realPath was checked leads outside of it.
+	contents, err := r.rootDir.ReadFile(relPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	value := strings.TrimRight(string(contents), "\r\n")
+	r.cache[realPath] = value
+
+	return value, nil
+}
+
+// isWithinDir returns true if the given path is within the given directory.
+// Both paths must be absolute and clean.
+func isWithinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// watch invalidates cached files when files are modified in their
+// directories, until the stop channel is closed.
+func (r *FileResolver) watch(stop <-chan struct{}) {
+	defer r.rootDir.Close()
+	defer r.watcher.Close()
+
+	for {
+		select {
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+
+			dir := filepath.Dir(filepath.Clean(event.Name))
+
+			r.mu.Lock()
+
+			for path := range r.cache {
+				if filepath.Dir(path) == dir {
+					delete(r.cache, path)
+				}
+			}
+
+			r.mu.Unlock()
+		case _, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			// Events may have been dropped, so invalidate all cached files.
+			r.mu.Lock()
+			clear(r.cache)
+			r.mu.Unlock()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// NewDefaultResolver returns a Resolver which supports the "file" and "env"
+// schemes, resolved by a FileResolver for the files within the given root
+// directory and an EnvResolver for the environment variables with the given
+// name prefix respectively.
+// The stop channel is used to signal when the file watcher should be closed.
+func NewDefaultResolver(fileRoot, envPrefix string, stop <-chan struct{}) (Resolver, error) {
+	fileResolver, err := NewFileResolver(fileRoot, stop)
+	if err != nil {
+		return nil, err
+	}
+
+	return SchemeResolver{
+		"env":  EnvResolver{Prefix: envPrefix},
+		"file": fileResolver,
+	}, nil
+}
+
+// ResolveJSON returns the given JSON document with every secret reference
+// replaced with the string value of the secret, resolved using the given
+// Resolver.
+// If the document contains no secret reference, it is returned unchanged.
+func ResolveJSON(ctx context.Context, r Resolver, data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte(ReferenceKey)) {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	doc, err := resolveValue(ctx, r, doc, "$")
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(doc)
+}
+
+// resolveValue returns the given decoded JSON value with every secret
+// reference replaced with the value of the secret.
+// path is the JSONPath of the value, used in error messages.
+func resolveValue(ctx context.Context, r Resolver, value any, path string) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		if rawRef, found := v[ReferenceKey]; found && len(v) == 1 {
+			ref, ok := rawRef.(string)
+			if !ok {
+				return nil, fmt.Errorf("secret reference at %s is not a string", path)
+			}
+
+			secret, err := r.Resolve(ctx, ref)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve secret reference at %s: %w", path, err)
+			}
+
+			return secret, nil
+		}
+
+		for key, field := range v {
+			resolved, err := resolveValue(ctx, r, field, path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+
+			v[key] = resolved
+		}
+	case []any:
+		for i, element := range v {
+			resolved, err := resolveValue(ctx, r, element, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+
+			v[i] = resolved
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sgnl-ai/adapter-framework/pkg/secrets"
+)
+
+func TestResolveJSON(t *testing.T) {
+	t.Setenv("TEST_CLIENT_SECRET", "the client secret")
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "private-key")
+
+	if err := os.WriteFile(keyPath, []byte("the private key\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	resolver, err := secrets.NewDefaultResolver(dir, "TEST_", stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		data    string
+		want    string
+		wantErr string
+	}{
+		"no_references": {
+			data: `{"a": 1.50, "b": "value"}`,
+			want: `{"a": 1.50, "b": "value"}`,
+		},
+		"references": {
+			data: `{"a":1.50,"auth":{"clientSecret":{"$secret":"env:TEST_CLIENT_SECRET"}},"keys":[{"$secret":"file:` + keyPath + `"}]}`,
+			want: `{"a":1.50,"auth":{"clientSecret":"the client secret"},"keys":["the private key"]}`,
+		},
+		"object_with_other_fields": {
+			data: `{"a":{"$secret":"env:TEST_CLIENT_SECRET","b":"c"}}`,
+			want: `{"a":{"$secret":"env:TEST_CLIENT_SECRET","b":"c"}}`,
+		},
+		"relative_file": {
+			data: `{"a":{"$secret":"file:private-key"}}`,
+			want: `{"a":"the private key"}`,
+		},
+		"env_without_prefix": {
+			data:    `{"a":{"$secret":"env:AUTH_TOKENS_PATH"}}`,
+			wantErr: "failed to resolve secret reference at $.a: secret environment variable AUTH_TOKENS_PATH does not have the required prefix",
+		},
+		"missing_env": {
+			data:    `{"a":{"$secret":"env:TEST_MISSING_SECRET"}}`,
+			wantErr: "failed to resolve secret reference at $.a: secret environment variable TEST_MISSING_SECRET is not set",
+		},
+		"unsupported_scheme": {
+			data:    `{"a":[{"$secret":"vault:path"}]}`,
+			wantErr: `failed to resolve secret reference at $.a[0]: secret reference "vault:path" has an unsupported scheme`,
+		},
+		"invalid_reference": {
+			data:    `{"a":{"$secret":12}}`,
+			wantErr: "secret reference at $.a is not a string",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := secrets.ResolveJSON(context.Background(), resolver, []byte(tc.data))
+
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("Expected error %q, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(got) != tc.want {
+				t.Errorf("Expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFileResolver_Reload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret")
+
+	if err := os.WriteFile(path, []byte("first"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	resolver, err := secrets.NewFileResolver(dir, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resolver.Resolve(context.Background(), path)
+	if err != nil || got != "first" {
+		t.Fatalf("Expected first, got %q (%v)", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte("second"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	got, err = resolver.Resolve(context.Background(), path)
+	if err != nil || got != "second" {
+		t.Fatalf("Expected second, got %q (%v)", got, err)
+	}
+
+	if _, err := resolver.Resolve(context.Background(), filepath.Join(dir, "missing")); err == nil || strings.Contains(err.Error(), "second") {
+		t.Errorf("Expected error without secret value, got %v", err)
+	}
+}
+
+func TestFileResolver_Relink(t *testing.T) {
+	root := t.TempDir()
+
+	for version, value := range map[string]string{"v1": "first", "v2": "second"} {
+		if err := os.Mkdir(filepath.Join(root, version), 0700); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(root, version, "secret"), []byte(value), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	link := filepath.Join(root, "secret")
+
+	if err := os.Symlink(filepath.Join("v1", "secret"), link); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	resolver, err := secrets.NewFileResolver(root, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resolver.Resolve(context.Background(), "secret")
+	if err != nil || got != "first" {
+		t.Fatalf("Expected first, got %q (%v)", got, err)
+	}
+
+	if err := os.Remove(link); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(filepath.Join("v2", "secret"), link); err != nil {
+		t.Fatal(err)
+	}
+
+	// The re-linked file is read again, without waiting for file events.
+	got, err = resolver.Resolve(context.Background(), "secret")
+	if err != nil || got != "second" {
+		t.Fatalf("Expected second, got %q (%v)", got, err)
+	}
+}
+
+func TestFileResolver_OutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+
+	outsidePath := filepath.Join(outside, "secret")
+
+	if err := os.WriteFile(outsidePath, []byte("outside"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(outsidePath, filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(outside, filepath.Join(root, "dirlink")); err != nil {
+		t.Fatal(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	resolver, err := secrets.NewFileResolver(root, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"absolute":           outsidePath,
+		"traversal":          filepath.Join(root, "..", filepath.Base(outside), "secret"),
+		"relative_traversal": filepath.Join("..", filepath.Base(outside), "secret"),
+		"symlink":            filepath.Join(root, "link"),
+		"directory_symlink":  filepath.Join(root, "dirlink", "secret"),
+		"root":               root,
+	}
+
+	for name, path := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolver.Resolve(context.Background(), path)
+			if err == nil || !strings.Contains(err.Error(), "outside of the secret files root directory") {
+				t.Errorf("Expected error for a file outside of the root directory, got %q (%v)", got, err)
+			}
+		})
+	}
+}
+
+func TestNewFileResolver_NoRoot(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	if _, err := secrets.NewFileResolver("", stop); err == nil {
+		t.Error("Expected an error for an empty root directory")
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// HandlerFunc gets a page of objects for the requested entity.
+type HandlerFunc[Config any] func(ctx context.Context, request *Request[Config]) Response
+
+// EntityHandlerFunc gets a page of objects for the requested entity, with the
+// options the entity's handler was registered with.
+type EntityHandlerFunc[Config, Options any] func(ctx context.Context, request *Request[Config], options Options) Response
+
+// Middleware wraps the handlers of a Router, e.g. to log, authenticate or
+// validate requests, or to post-process responses.
+type Middleware[Config any] func(next HandlerFunc[Config]) HandlerFunc[Config]
+
+// EntityInfo describes an entity, or a set of entities, supported by an
+// Adapter.
+type EntityInfo struct {
+	// ExternalID is the external ID of the entity, or a pattern matching the
+	// external IDs of the entities if Pattern is true.
+	ExternalID string
+
+	// Pattern indicates whether ExternalID is a pattern, in the syntax of
+	// path.Match.
+	Pattern bool
+}
+
+// EntityLister may be implemented by an Adapter to declare the entities it
+// supports, which are returned by the GetCapabilities RPC.
+type EntityLister interface {
+	// Entities returns the entities supported by the Adapter.
+	Entities() []EntityInfo
+}
+
+// Router is an Adapter which dispatches each request to the handler registered
+// for the requested entity's external ID, wrapped by the Router's middlewares.
+// Requests for entities without a handler fail with an
+// ERROR_CODE_INVALID_ENTITY_CONFIG error.
+//
+// Handlers must be registered and middlewares added before the Router is used
+// to get pages.
+type Router[Config any] struct {
+	// handlers maps external IDs to their handlers.
+	handlers map[string]HandlerFunc[Config]
+
+	// patterns are the handlers registered for patterns, in registration order.
+	patterns []patternHandler[Config]
+
+	// entities are the entities with a handler, in registration order.
+	entities []EntityInfo
+
+	middlewares []Middleware[Config]
+}
+
+// patternHandler is a handler registered for a pattern of external IDs.
+type patternHandler[Config any] struct {
+	pattern string
+	handler HandlerFunc[Config]
+}
+
+// NewRouter returns a Router without handlers.
+func NewRouter[Config any]() *Router[Config] {
+	return &Router[Config]{
+		handlers: make(map[string]HandlerFunc[Config]),
+	}
+}
+
+// Use adds the given middlewares to the Router. Each middleware wraps the
+// middlewares added after it, so that the first middleware added is called
+// first.
+func (r *Router[Config]) Use(middlewares ...Middleware[Config]) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+// HandleFunc registers the given handler for the entity with the given
+// external ID.
+// Returns an error if a handler is already registered for the external ID.
+func (r *Router[Config]) HandleFunc(externalID string, handler HandlerFunc[Config]) error {
+	if _, found := r.handlers[externalID]; found {
+		return fmt.Errorf("duplicate handler for entity %s", externalID)
+	}
+
+	r.handlers[externalID] = handler
+	r.entities = append(r.entities, EntityInfo{ExternalID: externalID})
+
+	return nil
+}
+
+// MustHandleFunc is like HandleFunc but panics if the handler cannot be
+// registered.
+func (r *Router[Config]) MustHandleFunc(externalID string, handler HandlerFunc[Config]) {
+	if err := r.HandleFunc(externalID, handler); err != nil {
+		panic(err)
+	}
+}
+
+// HandlePatternFunc registers the given handler for the entities which
+// external IDs match the given pattern, in the syntax of path.Match, e.g.
+// "custom_*".
+// Handlers registered for external IDs take precedence over handlers
+// registered for patterns, which are matched in registration order.
+// Returns an error if the pattern is malformed or if a handler is already
+// registered for the pattern.
+func (r *Router[Config]) HandlePatternFunc(pattern string, handler HandlerFunc[Config]) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid entity pattern %s: %w", pattern, err)
+	}
+
+	for _, p := range r.patterns {
+		if p.pattern == pattern {
+			return fmt.Errorf("duplicate handler for entity pattern %s", pattern)
+		}
+	}
+
+	r.patterns = append(r.patterns, patternHandler[Config]{
+		pattern: pattern,
+		handler: handler,
+	})
+	r.entities = append(r.entities, EntityInfo{ExternalID: pattern, Pattern: true})
+
+	return nil
+}
+
+// MustHandlePatternFunc is like HandlePatternFunc but panics if the handler
+// cannot be registered.
+func (r *Router[Config]) MustHandlePatternFunc(pattern string, handler HandlerFunc[Config]) {
+	if err := r.HandlePatternFunc(pattern, handler); err != nil {
+		panic(err)
+	}
+}
+
+// Handle registers the given handler for the entity with the given external
+// ID, which is called with the given options.
+// Returns an error if a handler is already registered for the external ID.
+func Handle[Config, Options any](
+	r *Router[Config],
+	externalID string,
+	options Options,
+	handler EntityHandlerFunc[Config, Options],
+) error {
+	return r.HandleFunc(externalID, withOptions(options, handler))
+}
+
+// MustHandle is like Handle but panics if the handler cannot be registered.
+func MustHandle[Config, Options any](
+	r *Router[Config],
+	externalID string,
+	options Options,
+	handler EntityHandlerFunc[Config, Options],
+) {
+	r.MustHandleFunc(externalID, withOptions(options, handler))
+}
+
+// HandlePattern registers the given handler for the entities which external
+// IDs match the given pattern, like Router.HandlePatternFunc, which is called
+// with the given options.
+// Returns an error if the pattern is malformed or if a handler is already
+// registered for the pattern.
+func HandlePattern[Config, Options any](
+	r *Router[Config],
+	pattern string,
+	options Options,
+	handler EntityHandlerFunc[Config, Options],
+) error {
+	return r.HandlePatternFunc(pattern, withOptions(options, handler))
+}
+
+// MustHandlePattern is like HandlePattern but panics if the handler cannot be
+// registered.
+func MustHandlePattern[Config, Options any](
+	r *Router[Config],
+	pattern string,
+	options Options,
+	handler EntityHandlerFunc[Config, Options],
+) {
+	r.MustHandlePatternFunc(pattern, withOptions(options, handler))
+}
+
+// withOptions returns a handler calling the given handler with the given
+// options.
+func withOptions[Config, Options any](options Options, handler EntityHandlerFunc[Config, Options]) HandlerFunc[Config] {
+	return func(ctx context.Context, request *Request[Config]) Response {
+		return handler(ctx, request, options)
+	}
+}
+
+// GetPage calls the handler of the requested entity, wrapped by the
+// middlewares of the Router.
+func (r *Router[Config]) GetPage(ctx context.Context, request *Request[Config]) Response {
+	handler := r.getHandler(request.Entity.ExternalId)
+	if handler == nil {
+		return NewGetPageResponseError(&Error{
+			Message:          fmt.Sprintf("Entity %s is not supported by the adapter.", request.Entity.ExternalId),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			EntityExternalID: request.Entity.ExternalId,
+		})
+	}
+
+	for i := len(r.middlewares) - 1; i >= 0; i-- {
+		handler = r.middlewares[i](handler)
+	}
+
+	return handler(ctx, request)
+}
+
+// getHandler returns the handler registered for the given external ID, or nil
+// if none.
+func (r *Router[Config]) getHandler(externalID string) HandlerFunc[Config] {
+	if handler, found := r.handlers[externalID]; found {
+		return handler
+	}
+
+	for _, p := range r.patterns {
+		// The pattern was validated when registered.
+		if matched, _ := path.Match(p.pattern, externalID); matched {
+			return p.handler
+		}
+	}
+
+	return nil
+}
+
+// Entities returns the entities with a handler, in registration order.
+func (r *Router[Config]) Entities() []EntityInfo {
+	return append([]EntityInfo(nil), r.entities...)
+}
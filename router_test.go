@@ -0,0 +1,222 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+type testRouterConfig struct {
+	Tenant string
+}
+
+type testEntityOptions struct {
+	Path string
+}
+
+func TestRouter(t *testing.T) {
+	router := NewRouter[testRouterConfig]()
+
+	// Each middleware appends its name to the "calls" attribute of the objects.
+	newMiddleware := func(name string) Middleware[testRouterConfig] {
+		return func(next HandlerFunc[testRouterConfig]) HandlerFunc[testRouterConfig] {
+			return func(ctx context.Context, request *Request[testRouterConfig]) Response {
+				resp := next(ctx, request)
+
+				if resp.Success != nil {
+					for _, object := range resp.Success.Objects {
+						object["calls"] = append(object["calls"].([]string), name)
+					}
+				}
+
+				return resp
+			}
+		}
+	}
+
+	router.Use(newMiddleware("inner"))
+
+	router.MustHandleFunc("users", func(ctx context.Context, request *Request[testRouterConfig]) Response {
+		return NewGetPageResponseSuccess(&Page{
+			Objects: []Object{{"handler": "users", "calls": []string{}}},
+		})
+	})
+
+	MustHandle(router, "groups", testEntityOptions{Path: "/groups"},
+		func(ctx context.Context, request *Request[testRouterConfig], options testEntityOptions) Response {
+			return NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": options.Path, "calls": []string{}}},
+			})
+		})
+
+	MustHandlePattern(router, "custom_*", testEntityOptions{Path: "/custom/"},
+		func(ctx context.Context, request *Request[testRouterConfig], options testEntityOptions) Response {
+			return NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": options.Path + request.Entity.ExternalId, "calls": []string{}}},
+			})
+		})
+
+	// Shadowed by the handler registered for the "custom_users" external ID.
+	router.MustHandlePatternFunc("custom_users", nil)
+
+	router.MustHandleFunc("custom_groups", func(ctx context.Context, request *Request[testRouterConfig]) Response {
+		return NewGetPageResponseSuccess(&Page{
+			Objects: []Object{{"handler": "custom_groups", "calls": []string{}}},
+		})
+	})
+
+	// Middlewares wrap all the handlers, including those registered before.
+	router.Use(newMiddleware("outer"))
+
+	tests := map[string]struct {
+		externalID string
+		wantResp   Response
+	}{
+		"external_id": {
+			externalID: "users",
+			wantResp: NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": "users", "calls": []string{"outer", "inner"}}},
+			}),
+		},
+		"external_id_with_options": {
+			externalID: "groups",
+			wantResp: NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": "/groups", "calls": []string{"outer", "inner"}}},
+			}),
+		},
+		"pattern": {
+			externalID: "custom_users",
+			wantResp: NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": "/custom/custom_users", "calls": []string{"outer", "inner"}}},
+			}),
+		},
+		"external_id_before_pattern": {
+			externalID: "custom_groups",
+			wantResp: NewGetPageResponseSuccess(&Page{
+				Objects: []Object{{"handler": "custom_groups", "calls": []string{"outer", "inner"}}},
+			}),
+		},
+		"unknown_entity": {
+			externalID: "devices",
+			wantResp: NewGetPageResponseError(&Error{
+				Message:          "Entity devices is not supported by the adapter.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				EntityExternalID: "devices",
+			}),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := &Request[testRouterConfig]{
+				Entity: EntityConfig{ExternalId: tc.externalID},
+			}
+
+			if gotResp := router.GetPage(context.Background(), request); !reflect.DeepEqual(tc.wantResp, gotResp) {
+				t.Errorf("Expected %#v, got %#v", tc.wantResp, gotResp)
+			}
+		})
+	}
+
+	wantEntities := []EntityInfo{
+		{ExternalID: "users"},
+		{ExternalID: "groups"},
+		{ExternalID: "custom_*", Pattern: true},
+		{ExternalID: "custom_users", Pattern: true},
+		{ExternalID: "custom_groups"},
+	}
+
+	if gotEntities := router.Entities(); !reflect.DeepEqual(wantEntities, gotEntities) {
+		t.Errorf("Expected entities %v, got %v", wantEntities, gotEntities)
+	}
+}
+
+func TestRouter_InvalidRegistration(t *testing.T) {
+	tests := map[string]struct {
+		register func(router *Router[testRouterConfig]) error
+		wantErr  string
+	}{
+		"duplicate_external_id": {
+			register: func(router *Router[testRouterConfig]) error {
+				router.MustHandleFunc("users", nil)
+
+				return router.HandleFunc("users", nil)
+			},
+			wantErr: "duplicate handler for entity users",
+		},
+		"duplicate_external_id_with_options": {
+			register: func(router *Router[testRouterConfig]) error {
+				router.MustHandleFunc("users", nil)
+
+				return Handle[testRouterConfig, testEntityOptions](router, "users", testEntityOptions{}, nil)
+			},
+			wantErr: "duplicate handler for entity users",
+		},
+		"duplicate_pattern": {
+			register: func(router *Router[testRouterConfig]) error {
+				router.MustHandlePatternFunc("custom_*", nil)
+
+				return router.HandlePatternFunc("custom_*", nil)
+			},
+			wantErr: "duplicate handler for entity pattern custom_*",
+		},
+		"invalid_pattern": {
+			register: func(router *Router[testRouterConfig]) error {
+				return router.HandlePatternFunc("custom_[", nil)
+			},
+			wantErr: "invalid entity pattern custom_[: syntax error in pattern",
+		},
+		"invalid_pattern_with_options": {
+			register: func(router *Router[testRouterConfig]) error {
+				return HandlePattern[testRouterConfig, testEntityOptions](router, "custom_[", testEntityOptions{}, nil)
+			},
+			wantErr: "invalid entity pattern custom_[: syntax error in pattern",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			router := NewRouter[testRouterConfig]()
+
+			err := tc.register(router)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("Expected error %q, got %v", tc.wantErr, err)
+			}
+
+			// The failed registration has no effect.
+			if len(router.Entities()) > 1 {
+				t.Errorf("Expected at most 1 entity, got %v", router.Entities())
+			}
+		})
+	}
+}
+
+func TestRouter_MustHandleFuncPanics(t *testing.T) {
+	router := NewRouter[testRouterConfig]()
+	router.MustHandleFunc("users", nil)
+
+	defer func() {
+		got, _ := recover().(error)
+		if got == nil || got.Error() != "duplicate handler for entity users" {
+			t.Errorf("Expected panic with error %q, got %v", "duplicate handler for entity users", got)
+		}
+	}()
+
+	router.MustHandleFunc("users", nil)
+}
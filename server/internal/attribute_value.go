@@ -16,81 +16,16 @@ package internal
 
 import (
 	"fmt"
-	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
-	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
-)
-
-var (
-	nullValue = &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_NullValue{
-		NullValue: &emptypb.Empty{},
-	}}
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
 )
 
+// validateAttributeValue returns an error if the type of the given value is not
+// valid for the given attribute, as defined by framework.IsValidAttributeValue.
 func validateAttributeValue(attribute *api_adapter_v1.AttributeConfig, value any) (adapterErr *api_adapter_v1.Error) {
-	if value == nil {
-		return nil
-	}
-
-	valid := false
-	switch value.(type) {
-	case []any:
-		value, _ := value.([]any)
-		valid = len(value) == 0 && attribute.List
-	case []bool:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL && attribute.List
-	case []*bool:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL && attribute.List
-	case []time.Time:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME && attribute.List
-	case []*time.Time:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME && attribute.List
-	case []framework.Duration:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION && attribute.List
-	case []*framework.Duration:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION && attribute.List
-	case []float64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE && attribute.List
-	case []*float64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE && attribute.List
-	case []int64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64 && attribute.List
-	case []*int64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64 && attribute.List
-	case []string:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING && attribute.List
-	case []*string:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING && attribute.List
-	case bool:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL && !attribute.List
-	case *bool:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL && !attribute.List
-	case time.Time:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME && !attribute.List
-	case *time.Time:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME && !attribute.List
-	case framework.Duration:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION && !attribute.List
-	case *framework.Duration:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION && !attribute.List
-	case float64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE && !attribute.List
-	case *float64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE && !attribute.List
-	case int64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64 && !attribute.List
-	case *int64:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64 && !attribute.List
-	case string:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING && !attribute.List
-	case *string:
-		valid = attribute.Type == api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING && !attribute.List
-	}
-
-	if !valid {
+	if !framework.IsValidAttributeValue(framework.AttributeType(attribute.Type), attribute.List, value) {
 		return &api_adapter_v1.Error{
 			Message: fmt.Sprintf("Adapter returned a value with invalid type %T for attribute %s (%s) with type %s (list=%t). This is always indicative of a bug within the Adapter implementation.",
 				value, attribute.Id, attribute.ExternalId, attribute.Type, attribute.List),
@@ -101,144 +36,144 @@ func validateAttributeValue(attribute *api_adapter_v1.AttributeConfig, value any
 	return nil
 }
 
-// getAttributeValues converts the singleton value or list of values for an
-// attribute.
-// Returns an error if the value's type is invalid.
-func getAttributeValues(value any) (list []*api_adapter_v1.AttributeValue, adapterErr *api_adapter_v1.Error) {
-	switch v := value.(type) {
-	case []any:
-		// validateAttributeValue only allows empty []any lists.
-		// So if the value has already been validated, we know it must be empty and we return an empty list.
-		// If v is not empty, getAttributeListValues will call getAttributeValue which will return an error.
-		return getAttributeListValues(v)
-	case []bool:
-		return getAttributeListValues(v)
-	case []*bool:
-		return getAttributeListValues(v)
-	case []time.Time:
-		return getAttributeListValues(v)
-	case []*time.Time:
-		return getAttributeListValues(v)
-	case []framework.Duration:
-		return getAttributeListValues(v)
-	case []*framework.Duration:
-		return getAttributeListValues(v)
-	case []float64:
-		return getAttributeListValues(v)
-	case []*float64:
-		return getAttributeListValues(v)
-	case []int64:
-		return getAttributeListValues(v)
-	case []*int64:
-		return getAttributeListValues(v)
-	case []string:
-		return getAttributeListValues(v)
-	case []*string:
-		return getAttributeListValues(v)
-	default: // Non-list attribute value.
-		var singleValue *api_adapter_v1.AttributeValue
-
-		singleValue, adapterErr = getAttributeValue(v)
-		if adapterErr != nil {
-			return
+// attributeConverter converts the singleton value or list of values for an
+// attribute into RPC attribute values allocated from the given allocator.
+// Returns nil values if the value is null.
+// Returns false if the value's type is invalid for the attribute, in which case
+// validateAttributeValue returns the corresponding error.
+type attributeConverter func(alloc *rpcvalue.Allocator, value any) (values []*api_adapter_v1.AttributeValue, valid bool)
+
+// newAttributeConverter returns the converter for the values of the given
+// attribute, which only accepts the value types valid for the attribute's
+// type and list flag, as defined by framework.IsValidAttributeValue.
+func newAttributeConverter(attribute *api_adapter_v1.AttributeConfig) attributeConverter {
+	attributeType := framework.AttributeType(attribute.Type)
+	convert := newValueConverter(attribute)
+
+	return func(alloc *rpcvalue.Allocator, value any) ([]*api_adapter_v1.AttributeValue, bool) {
+		if !framework.IsValidAttributeValue(attributeType, attribute.List, value) {
+			return nil, false
 		}
 
-		if singleValue != nullValue {
-			list = []*api_adapter_v1.AttributeValue{singleValue}
-		}
-
-		return
+		return convert(alloc, value)
 	}
 }
 
-// getAttributeListValues converts a list of values for an attribute.
-// Returns an error if the value is a list or if its type is invalid.
-func getAttributeListValues[Element any](listValue []Element) (list []*api_adapter_v1.AttributeValue, adapterErr *api_adapter_v1.Error) {
-	if listValue == nil {
-		return nil, nil
-	}
-
-	list = make([]*api_adapter_v1.AttributeValue, len(listValue))
-	for i, e := range listValue {
-		list[i], adapterErr = getAttributeValue(e)
-		if adapterErr != nil {
-			return nil, adapterErr
+// newValueConverter returns the converter for the values of
+// the given attribute, which must be valid for the attribute.
+func newValueConverter(attribute *api_adapter_v1.AttributeConfig) attributeConverter {
+	switch attribute.Type {
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewBoolValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewDateTimeValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION:
+		return newTypedAttributeConverter(attribute.List, newDurationValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewDoubleValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewInt64Value)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewStringValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES:
+		return newTypedAttributeConverter(attribute.List, (*rpcvalue.Allocator).NewBytesValue)
+	case api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_UUID:
+		return newTypedAttributeConverter(attribute.List, newUUIDValue)
+	default:
+		return func(*rpcvalue.Allocator, any) ([]*api_adapter_v1.AttributeValue, bool) {
+			return nil, false
 		}
 	}
-
-	return
 }
 
-// getAttributeValue converts a single value for an attribute.
-// Returns an error if the value is a list or if its type is invalid.
-func getAttributeValue(value any) (*api_adapter_v1.AttributeValue, *api_adapter_v1.Error) {
-	if value == nil {
-		return nullValue, nil
+// newTypedAttributeConverter returns the converter for an attribute whose
+// values have type Element, or *Element, using newValue to convert each
+// non-null value.
+func newTypedAttributeConverter[Element any](
+	list bool,
+	newValue func(*rpcvalue.Allocator, Element) *api_adapter_v1.AttributeValue,
+) attributeConverter {
+	if list {
+		return func(alloc *rpcvalue.Allocator, value any) ([]*api_adapter_v1.AttributeValue, bool) {
+			switch v := value.(type) {
+			case nil:
+				return nil, true
+			case []Element:
+				if v == nil {
+					return nil, true
+				}
+
+				values := alloc.NewValueList(len(v))
+				for i, e := range v {
+					values[i] = newValue(alloc, e)
+				}
+
+				return values, true
+			case []*Element:
+				if v == nil {
+					return nil, true
+				}
+
+				values := alloc.NewValueList(len(v))
+				for i, e := range v {
+					if e == nil {
+						values[i] = rpcvalue.Null
+					} else {
+						values[i] = newValue(alloc, *e)
+					}
+				}
+
+				return values, true
+			case []any: // Empty list of unknown type.
+				if v == nil {
+					return nil, true
+				}
+
+				return alloc.NewValueList(0), true
+			default:
+				return nil, false
+			}
+		}
 	}
 
-	switch v := value.(type) {
-	case bool:
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_BoolValue{
-			BoolValue: v,
-		}}, nil
-	case *bool:
-		if v == nil {
-			return nullValue, nil
-		}
-		return getAttributeValue(*v)
-	case time.Time:
-		_, timezoneOffset := v.Zone()
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_DatetimeValue{
-			DatetimeValue: &api_adapter_v1.DateTime{
-				Timestamp:      timestamppb.New(v),
-				TimezoneOffset: int32(timezoneOffset),
-			},
-		}}, nil
-	case *time.Time:
-		if v == nil {
-			return nullValue, nil
-		}
-		return getAttributeValue(*v)
-	case framework.Duration:
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_DurationValue{
-			DurationValue: &api_adapter_v1.Duration{Nanos: v.Nanos, Seconds: v.Seconds, Days: v.Days, Months: v.Months},
-		}}, nil
-	case *framework.Duration:
-		if v == nil {
-			return nullValue, nil
-		}
-		return getAttributeValue(*v)
-	case float64:
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_DoubleValue{
-			DoubleValue: v,
-		}}, nil
-	case *float64:
-		if v == nil {
-			return nullValue, nil
-		}
-		return getAttributeValue(*v)
-	case int64:
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_Int64Value{
-			Int64Value: v,
-		}}, nil
-	case *int64:
-		if v == nil {
-			return nullValue, nil
-		}
-		return getAttributeValue(*v)
-	case string:
-		return &api_adapter_v1.AttributeValue{Value: &api_adapter_v1.AttributeValue_StringValue{
-			StringValue: v,
-		}}, nil
-	case *string:
-		if v == nil {
-			return nullValue, nil
+	return func(alloc *rpcvalue.Allocator, value any) ([]*api_adapter_v1.AttributeValue, bool) {
+		var singleValue *api_adapter_v1.AttributeValue
+
+		switch v := value.(type) {
+		case nil:
+			return nil, true
+		case Element:
+			singleValue = newValue(alloc, v)
+		case *Element:
+			if v == nil {
+				return nil, true
+			}
+
+			singleValue = newValue(alloc, *v)
+		default:
+			return nil, false
 		}
-		return getAttributeValue(*v)
-	default:
-		return nil, &api_adapter_v1.Error{
-			Message: fmt.Sprintf("Adapter returned an attribute value with invalid type: %T. This is always indicative of a bug within the Adapter implementation.", value),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+
+		// As an optimization, ignore the attribute value if it is null, as it is
+		// equivalent to returning null.
+		if singleValue == rpcvalue.Null {
+			return nil, true
 		}
+
+		values := alloc.NewValueList(1)
+		values[0] = singleValue
+
+		return values, true
 	}
 }
+
+// newDurationValue returns a new RPC duration value allocated from the given
+// allocator.
+func newDurationValue(alloc *rpcvalue.Allocator, v framework.Duration) *api_adapter_v1.AttributeValue {
+	return alloc.NewDurationValue(v.Nanos, v.Seconds, v.Days, v.Months)
+}
+
+// newUUIDValue returns a new RPC UUID value allocated from the given allocator.
+func newUUIDValue(alloc *rpcvalue.Allocator, v framework.UUID) *api_adapter_v1.AttributeValue {
+	return alloc.NewUUIDValue(v.String())
+}
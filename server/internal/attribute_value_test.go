@@ -21,6 +21,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -297,6 +298,44 @@ func TestValidateAttributeValue(t *testing.T) {
 				Code:    11, // ERROR_CODE_INTERNAL
 			},
 		},
+		"bytes": {
+			attribute: &api_adapter_v1.AttributeConfig{
+				Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+				ExternalId: "objectSid",
+				Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES,
+			},
+			value: []byte{0x01, 0x02},
+		},
+		"bytes_list": {
+			attribute: &api_adapter_v1.AttributeConfig{
+				Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+				ExternalId: "certificates",
+				Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES,
+				List:       true,
+			},
+			value: [][]byte{{0x01}, {0x02}},
+		},
+		"uuid": {
+			attribute: &api_adapter_v1.AttributeConfig{
+				Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+				ExternalId: "objectGUID",
+				Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_UUID,
+			},
+			value: framework.UUID{},
+		},
+		"uuid_list_for_bytes_attribute": {
+			attribute: &api_adapter_v1.AttributeConfig{
+				Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+				ExternalId: "something",
+				Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES,
+				List:       true,
+			},
+			value: []framework.UUID{{}},
+			wantAdapterErr: &api_adapter_v1.Error{
+				Message: "Adapter returned a value with invalid type []framework.UUID for attribute 12268f03-f99d-476f-91cc-5fe3404e1654 (something) with type ATTRIBUTE_TYPE_BYTES (list=true). This is always indicative of a bug within the Adapter implementation.",
+				Code:    11, // ERROR_CODE_INTERNAL
+			},
+		},
 		"mismatched_types": {
 			attribute: &api_adapter_v1.AttributeConfig{
 				Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
@@ -319,76 +358,103 @@ func TestValidateAttributeValue(t *testing.T) {
 	}
 }
 
-func TestGetAttributeValues(t *testing.T) {
+func TestAttributeConverter(t *testing.T) {
 	timeValue, _ := time.Parse(time.RFC3339, "2023-06-23T12:34:56-07:00")
 
 	tests := map[string]struct {
+		attribute                   *api_adapter_v1.AttributeConfig
 		value                       any
 		wantAttributeValuesListJSON *string
-		wantError                   *api_adapter_v1.Error
+		wantInvalid                 bool
 	}{
 		"null": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
 			value:                       nil,
 			wantAttributeValuesListJSON: nil,
 		},
 		"empty_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL, List: true},
 			value:                       []bool{},
 			wantAttributeValuesListJSON: Ptr(`[]`),
 		},
 		"empty_any_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
 			value:                       []any{},
 			wantAttributeValuesListJSON: Ptr(`[]`),
 		},
+		"bytes": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES},
+			value:                       []byte("hello"),
+			wantAttributeValuesListJSON: Ptr(`[{"bytesValue":"aGVsbG8="}]`),
+		},
+		"bytes_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES, List: true},
+			value:                       [][]byte{[]byte("hello"), []byte("world")},
+			wantAttributeValuesListJSON: Ptr(`[{"bytesValue":"aGVsbG8="},{"bytesValue":"d29ybGQ="}]`),
+		},
+		"uuid_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_UUID, List: true},
+			value:                       []framework.UUID{{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}},
+			wantAttributeValuesListJSON: Ptr(`[{"uuidValue":"123e4567-e89b-12d3-a456-426614174000"}]`),
+		},
 		"non_empty_any_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
 			value:                       []any{1234, "abcd"},
 			wantAttributeValuesListJSON: nil,
-			wantError: &api_adapter_v1.Error{
-				// The type is int because that's the first value in the list.
-				Message: "Adapter returned an attribute value with invalid type: int. This is always indicative of a bug within the Adapter implementation.",
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-			},
+			wantInvalid:                 true,
 		},
 		"bool": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL},
 			value:                       true,
 			wantAttributeValuesListJSON: Ptr(`[{"boolValue":true}]`),
 		},
 		"bool_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL},
 			value:                       Ptr(true),
 			wantAttributeValuesListJSON: Ptr(`[{"boolValue":true}]`),
 		},
 		"bool_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL, List: true},
 			value:                       []bool{true, false, true},
 			wantAttributeValuesListJSON: Ptr(`[{"boolValue":true},{"boolValue":false},{"boolValue":true}]`),
 		},
 		"bool_pointer_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL, List: true},
 			value:                       []*bool{Ptr(true), (*bool)(nil), Ptr(true)},
 			wantAttributeValuesListJSON: Ptr(`[{"boolValue":true},{"nullValue":{}},{"boolValue":true}]`),
 		},
 		"time": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME},
 			value:                       timeValue,
 			wantAttributeValuesListJSON: Ptr(`[{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}}]`),
 		},
 		"time_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME},
 			value:                       Ptr(timeValue),
 			wantAttributeValuesListJSON: Ptr(`[{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}}]`),
 		},
 		"time_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME, List: true},
 			value:                       []time.Time{timeValue, timeValue.Add(2 * time.Second)},
 			wantAttributeValuesListJSON: Ptr(`[{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}},{"datetimeValue":{"timestamp":"2023-06-23T19:34:58Z", "timezoneOffset":-25200}}]`),
 		},
 		"time_pointer_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME, List: true},
 			value:                       []*time.Time{Ptr(timeValue), (*time.Time)(nil)},
 			wantAttributeValuesListJSON: Ptr(`[{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}},{"nullValue":{}}]`),
 		},
 		"duration": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION},
 			value:                       framework.Duration{Nanos: 10, Seconds: 20, Days: 30, Months: 4},
 			wantAttributeValuesListJSON: Ptr(`[{"durationValue":{"days":"30", "months":"4", "nanos":10, "seconds":"20"}}]`),
 		},
 		"duration_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION},
 			value:                       Ptr(framework.Duration{Nanos: 10, Seconds: 20, Days: 30, Months: 4}),
 			wantAttributeValuesListJSON: Ptr(`[{"durationValue":{"days":"30", "months":"4", "nanos":10, "seconds":"20"}}]`),
 		},
 		"duration_list": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION, List: true},
 			value: []framework.Duration{
 				{Nanos: 10, Seconds: 20, Days: 30, Months: 4},
 				{Nanos: 11, Seconds: 22, Days: 33, Months: 5},
@@ -400,6 +466,7 @@ func TestGetAttributeValues(t *testing.T) {
 					`]`),
 		},
 		"duration_pointer_list": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DURATION, List: true},
 			value: []*framework.Duration{
 				Ptr(framework.Duration{Nanos: 10, Seconds: 20, Days: 30, Months: 4}),
 				(*framework.Duration)(nil),
@@ -411,66 +478,125 @@ func TestGetAttributeValues(t *testing.T) {
 					`]`),
 		},
 		"double": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE},
 			value:                       float64(123.45),
 			wantAttributeValuesListJSON: Ptr(`[{"doubleValue":123.45}]`),
 		},
 		"double_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE},
 			value:                       Ptr(float64(123.45)),
 			wantAttributeValuesListJSON: Ptr(`[{"doubleValue":123.45}]`),
 		},
 		"double_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE, List: true},
 			value:                       []float64{float64(123.45), float64(136.56)},
 			wantAttributeValuesListJSON: Ptr(`[{"doubleValue":123.45},{"doubleValue":136.56}]`),
 		},
 		"double_pointer_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DOUBLE, List: true},
 			value:                       []*float64{Ptr(float64(123.45)), (*float64)(nil)},
 			wantAttributeValuesListJSON: Ptr(`[{"doubleValue":123.45},{"nullValue":{}}]`),
 		},
 		"int64": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
 			value:                       int64(1234),
 			wantAttributeValuesListJSON: Ptr(`[{"int64Value":"1234"}]`),
 		},
 		"int64_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
 			value:                       Ptr(int64(1234)),
 			wantAttributeValuesListJSON: Ptr(`[{"int64Value":"1234"}]`),
 		},
 		"int64_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64, List: true},
 			value:                       []int64{int64(1234), int64(1357)},
 			wantAttributeValuesListJSON: Ptr(`[{"int64Value":"1234"},{"int64Value":"1357"}]`),
 		},
 		"int64_pointer_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64, List: true},
 			value:                       []*int64{Ptr(int64(1234)), (*int64)(nil)},
 			wantAttributeValuesListJSON: Ptr(`[{"int64Value":"1234"},{"nullValue":{}}]`),
 		},
 		"string": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
 			value:                       "abcd",
 			wantAttributeValuesListJSON: Ptr(`[{"stringValue":"abcd"}]`),
 		},
 		"string_pointer": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
 			value:                       Ptr("abcd"),
 			wantAttributeValuesListJSON: Ptr(`[{"stringValue":"abcd"}]`),
 		},
 		"string_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
 			value:                       []string{"a", "b"},
 			wantAttributeValuesListJSON: Ptr(`[{"stringValue":"a"},{"stringValue":"b"}]`),
 		},
 		"string_pointer_list": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
 			value:                       []*string{Ptr("a"), (*string)(nil)},
 			wantAttributeValuesListJSON: Ptr(`[{"stringValue":"a"},{"nullValue":{}}]`),
 		},
 		"invalid_int32": {
-			value: int32(1234),
-			wantError: &api_adapter_v1.Error{
-				Message: "Adapter returned an attribute value with invalid type: int32. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
-			},
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
+			value:       int32(1234),
+			wantInvalid: true,
 		},
 		"invalid_int64_pointer_pointer": {
-			value: Ptr(Ptr(int64(1234))),
-			wantError: &api_adapter_v1.Error{
-				Message: "Adapter returned an attribute value with invalid type: **int64. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
-			},
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
+			value:       Ptr(Ptr(int64(1234))),
+			wantInvalid: true,
+		},
+		"bool_pointer_null": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL},
+			value:     (*bool)(nil),
+		},
+		"bytes_null": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES},
+			value:     []byte(nil),
+		},
+		"bytes_list_with_null": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES, List: true},
+			value:                       [][]byte{nil, []byte("hello")},
+			wantAttributeValuesListJSON: Ptr(`[{"nullValue":{}},{"bytesValue":"aGVsbG8="}]`),
+		},
+		"uuid_pointer_null": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_UUID},
+			value:     (*framework.UUID)(nil),
+		},
+		"string_list_null": {
+			attribute: &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
+			value:     []string(nil),
+		},
+		"string_pointer_list_all_null": {
+			attribute:                   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
+			value:                       []*string{nil},
+			wantAttributeValuesListJSON: Ptr(`[{"nullValue":{}}]`),
+		},
+		"invalid_single_value_for_list": {
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true},
+			value:       "abcd",
+			wantInvalid: true,
+		},
+		"invalid_list_for_single_value": {
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			value:       []string{"abcd"},
+			wantInvalid: true,
+		},
+		"invalid_type": {
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			value:       int64(1234),
+			wantInvalid: true,
+		},
+		"invalid_int32_list": {
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64, List: true},
+			value:       []int32{12, 34, 56},
+			wantInvalid: true,
+		},
+		"invalid_bytes_pointer": {
+			attribute:   &api_adapter_v1.AttributeConfig{Id: "a", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BYTES},
+			value:       Ptr([]byte("hello")),
+			wantInvalid: true,
 		},
 	}
 
@@ -478,7 +604,7 @@ func TestGetAttributeValues(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			// Unmarshal the expected list of protobuf messages marshaled into
 			// JSON so that it can be compared using reflect.DeepEqual with the
-			// list of protobuf messages returned by getAttributeValues.
+			// list of protobuf messages returned by the converter.
 			// Using JSON in test cases is more readable than instantiating
 			// protobuf structs.
 			var wantAttributeValuesAsListOfMap []map[string]any
@@ -489,7 +615,7 @@ func TestGetAttributeValues(t *testing.T) {
 				}
 			}
 
-			gotAttributeValues, gotError := getAttributeValues(tc.value)
+			gotAttributeValues, gotValid := newAttributeConverter(tc.attribute)(new(rpcvalue.Allocator), tc.value)
 
 			var gotAttributeValuesAsListOfMap []map[string]any
 			if gotAttributeValues != nil {
@@ -512,212 +638,8 @@ func TestGetAttributeValues(t *testing.T) {
 				}
 			}
 
-			AssertDeepEqual(t, tc.wantError, gotError)
+			AssertDeepEqual(t, !tc.wantInvalid, gotValid)
 			AssertDeepEqual(t, wantAttributeValuesAsListOfMap, gotAttributeValuesAsListOfMap)
 		})
 	}
 }
-
-func TestGetAttributeListValues(t *testing.T) {
-	gotList, gotError := getAttributeListValues[string](nil)
-	AssertDeepEqual(t, (*api_adapter_v1.Error)(nil), gotError)
-	AssertDeepEqual(t, []*api_adapter_v1.AttributeValue(nil), gotList)
-
-	testGetAttributeListValues(t, []string{}, "[]", nil)
-	testGetAttributeListValues(t, []string{"abcd"}, `[{"stringValue":"abcd"}]`, nil)
-	testGetAttributeListValues(t, []string{"a", "b", "c"}, `[{"stringValue":"a"},{"stringValue":"b"},{"stringValue":"c"}]`, nil)
-	testGetAttributeListValues(t, []*string{nil}, `[{"nullValue":{}}]`, nil)
-	testGetAttributeListValues(t, []*string{Ptr("a"), nil, Ptr("c")}, `[{"stringValue":"a"},{"nullValue":{}},{"stringValue":"c"}]`, nil)
-	testGetAttributeListValues(t, []int64{}, "[]", nil)
-	testGetAttributeListValues(t, []int64{123}, `[{"int64Value":"123"}]`, nil)
-	testGetAttributeListValues(t, []int64{12, 34, 56}, `[{"int64Value":"12"},{"int64Value":"34"},{"int64Value":"56"}]`, nil)
-	testGetAttributeListValues(t, []*int64{nil}, `[{"nullValue":{}}]`, nil)
-	testGetAttributeListValues(t, []*int64{Ptr(int64(12)), nil, Ptr(int64(56))}, `[{"int64Value":"12"},{"nullValue":{}},{"int64Value":"56"}]`, nil)
-
-	testGetAttributeListValues(t, []int32{12, 34, 56}, "",
-		&api_adapter_v1.Error{
-			Message: "Adapter returned an attribute value with invalid type: int32. This is always indicative of a bug within the Adapter implementation.",
-			Code:    11, // ERROR_CODE_INTERNAL
-		})
-	testGetAttributeListValues(t, []**int64{Ptr(Ptr(int64(12))), nil, Ptr(Ptr(int64(56)))}, "",
-		&api_adapter_v1.Error{
-			Message: "Adapter returned an attribute value with invalid type: **int64. This is always indicative of a bug within the Adapter implementation.",
-			Code:    11, // ERROR_CODE_INTERNAL
-		})
-}
-
-func testGetAttributeListValues[Element any](t *testing.T, listValue []Element, wantListAsJSON string, wantError *api_adapter_v1.Error) {
-	t.Helper()
-
-	var wantListOfMaps []map[string]any
-	if wantListAsJSON != "" {
-		err := json.Unmarshal([]byte(wantListAsJSON), &wantListOfMaps)
-		if err != nil {
-			t.Fatalf("Failed to unmarshal expected list of Protocol Buffer message: %v", err)
-		}
-	}
-
-	gotList, gotError := getAttributeListValues(listValue)
-
-	var gotListOfMaps []map[string]any
-	if gotList != nil {
-		gotListOfMaps = make([]map[string]any, 0, len(gotList))
-		for _, gotAttributeValue := range gotList {
-			var gotAttributeValueAsMap map[string]any
-			if gotAttributeValue != nil {
-				gotAttributeValueJSON, err := protojson.MarshalOptions{}.Marshal(gotAttributeValue)
-				if err != nil {
-					t.Fatalf("Failed to marshal Protocol Buffer message: %v", err)
-				}
-
-				gotAttributeValueAsMap = make(map[string]any)
-				err = json.Unmarshal(gotAttributeValueJSON, &gotAttributeValueAsMap)
-				if err != nil {
-					t.Fatalf("Failed to unmarshal Protocol Buffer message: %v", err)
-				}
-			}
-			gotListOfMaps = append(gotListOfMaps, gotAttributeValueAsMap)
-		}
-	}
-
-	AssertDeepEqual(t, wantError, gotError)
-	AssertDeepEqual(t, wantListOfMaps, gotListOfMaps)
-}
-
-func TestGetAttributeValue(t *testing.T) {
-	timeValue, _ := time.Parse(time.RFC3339, "2023-06-23T12:34:56-07:00")
-
-	tests := map[string]struct {
-		value                  any
-		wantAttributeValueJSON *string
-		wantError              *api_adapter_v1.Error
-	}{
-		"null": {
-			value:                  nil,
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"bool": {
-			value:                  true,
-			wantAttributeValueJSON: Ptr(`{"boolValue":true}`),
-		},
-		"bool_pointer": {
-			value:                  Ptr(true),
-			wantAttributeValueJSON: Ptr(`{"boolValue":true}`),
-		},
-		"bool_pointer_null": {
-			value:                  (*bool)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"time": {
-			value:                  timeValue,
-			wantAttributeValueJSON: Ptr(`{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}}`),
-		},
-		"time_pointer": {
-			value:                  Ptr(timeValue),
-			wantAttributeValueJSON: Ptr(`{"datetimeValue":{"timestamp":"2023-06-23T19:34:56Z", "timezoneOffset":-25200}}`),
-		},
-		"time_pointer_null": {
-			value:                  (*time.Time)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"duration": {
-			value:                  framework.Duration{Nanos: 10, Seconds: 20, Days: 30, Months: 4},
-			wantAttributeValueJSON: Ptr(`{"durationValue":{"days":"30", "months":"4", "nanos":10, "seconds":"20"}}`),
-		},
-		"duration_pointer": {
-			value:                  Ptr(framework.Duration{Nanos: 10, Seconds: 20, Days: 30, Months: 4}),
-			wantAttributeValueJSON: Ptr(`{"durationValue":{"days":"30", "months":"4", "nanos":10, "seconds":"20"}}`),
-		},
-		"duration_pointer_null": {
-			value:                  (*framework.Duration)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"double": {
-			value:                  float64(123.45),
-			wantAttributeValueJSON: Ptr(`{"doubleValue":123.45}`),
-		},
-		"double_pointer": {
-			value:                  Ptr(float64(123.45)),
-			wantAttributeValueJSON: Ptr(`{"doubleValue":123.45}`),
-		},
-		"double_pointer_null": {
-			value:                  (*float64)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"int64": {
-			value:                  int64(1234),
-			wantAttributeValueJSON: Ptr(`{"int64Value":"1234"}`),
-		},
-		"int64_pointer": {
-			value:                  Ptr(int64(1234)),
-			wantAttributeValueJSON: Ptr(`{"int64Value":"1234"}`),
-		},
-		"int64_pointer_null": {
-			value:                  (*int64)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"string": {
-			value:                  "abcd",
-			wantAttributeValueJSON: Ptr(`{"stringValue":"abcd"}`),
-		},
-		"string_pointer": {
-			value:                  Ptr("abcd"),
-			wantAttributeValueJSON: Ptr(`{"stringValue":"abcd"}`),
-		},
-		"string_pointer_null": {
-			value:                  (*string)(nil),
-			wantAttributeValueJSON: Ptr(`{"nullValue":{}}`),
-		},
-		"invalid_int32": {
-			value: int32(1234),
-			wantError: &api_adapter_v1.Error{
-				Message: "Adapter returned an attribute value with invalid type: int32. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
-			},
-		},
-		"invalid_int64_pointer_pointer": {
-			value: Ptr(Ptr(int64(1234))),
-			wantError: &api_adapter_v1.Error{
-				Message: "Adapter returned an attribute value with invalid type: **int64. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
-			},
-		},
-	}
-
-	for name, tc := range tests {
-		t.Run(name, func(t *testing.T) {
-			// Unmarshal the expected protobuf message marshaled into JSON so
-			// that it can be compared using reflect.DeepEqual with the
-			// protobuf message returned by getAttributeValue.
-			// Using JSON in test cases is more readable than instantiating
-			// protobuf structs.
-			var wantAttributeValueAsMap map[string]any
-			if tc.wantAttributeValueJSON != nil {
-				wantAttributeValueAsMap = make(map[string]any)
-				err := json.Unmarshal([]byte(*tc.wantAttributeValueJSON), &wantAttributeValueAsMap)
-				if err != nil {
-					t.Fatalf("Failed to unmarshal expected Protocol Buffer message: %v", err)
-				}
-			}
-
-			gotAttributeValue, gotError := getAttributeValue(tc.value)
-
-			var gotAttributeValueAsMap map[string]any
-			if gotAttributeValue != nil {
-				gotAttributeValueJSON, err := protojson.MarshalOptions{}.Marshal(gotAttributeValue)
-				if err != nil {
-					t.Fatalf("Failed to marshal Protocol Buffer message: %v", err)
-				}
-
-				gotAttributeValueAsMap = make(map[string]any)
-				err = json.Unmarshal(gotAttributeValueJSON, &gotAttributeValueAsMap)
-				if err != nil {
-					t.Fatalf("Failed to unmarshal Protocol Buffer message: %v", err)
-				}
-			}
-
-			AssertDeepEqual(t, tc.wantError, gotError)
-			AssertDeepEqual(t, wantAttributeValueAsMap, gotAttributeValueAsMap)
-		})
-	}
-}
@@ -0,0 +1,264 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// AuditOutcomeSuccess is the outcome of an AuditRecord for a successful GetPage
+// request. The outcome of a failed request is the name of its error code.
+const AuditOutcomeSuccess = "SUCCESS"
+
+// AuditRecord records which data was returned to which client by a GetPage request.
+// It never contains datasource credentials, configs or cursors.
+type AuditRecord struct {
+	// Time is the time at which the request was received.
+	Time time.Time `json:"time"`
+
+	// TokenIdentity identifies the auth token the request was authenticated with,
+	// without revealing it.
+	TokenIdentity string `json:"tokenIdentity"`
+
+	TenantID         string `json:"tenantId,omitempty"`
+	ClientID         string `json:"clientId,omitempty"`
+	DatasourceID     string `json:"datasourceId"`
+	DatasourceType   string `json:"datasourceType"`
+	EntityID         string `json:"entityId"`
+	EntityExternalID string `json:"entityExternalId"`
+	PageSize         int64  `json:"pageSize"`
+
+	// ObjectCount is the number of top-level objects returned.
+	ObjectCount int `json:"objectCount"`
+
+	// Outcome is AuditOutcomeSuccess, or the name of the error code returned.
+	Outcome string `json:"outcome"`
+
+	// DurationMillis is the duration of the request in milliseconds.
+	DurationMillis int64 `json:"durationMillis"`
+}
+
+// AuditSink records an AuditRecord for every authenticated GetPage request.
+// Implementations must be safe for concurrent use, and should not block for
+// long, since records are written before the response is returned.
+type AuditSink interface {
+	Audit(record *AuditRecord) error
+}
+
+// getTokenIdentity returns a stable identity of the given auth token, which
+// doesn't allow recovering the token.
+func getTokenIdentity(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// newAuditRecord returns the AuditRecord of the given request, authenticated with
+// the token with the given identity and received at the given time, and of its
+// response.
+func newAuditRecord(
+	tokenIdentity string,
+	start time.Time,
+	req *api_adapter_v1.GetPageRequest,
+	resp *api_adapter_v1.GetPageResponse,
+) *AuditRecord {
+	outcome := AuditOutcomeSuccess
+	if adapterErr := resp.GetError(); adapterErr != nil {
+		outcome = adapterErr.GetCode().String()
+	}
+
+	return &AuditRecord{
+		Time:             start.UTC(),
+		TokenIdentity:    tokenIdentity,
+		TenantID:         req.GetTenantId(),
+		ClientID:         req.GetClientId(),
+		DatasourceID:     req.GetDatasource().GetId(),
+		DatasourceType:   req.GetDatasource().GetType(),
+		EntityID:         req.GetEntity().GetId(),
+		EntityExternalID: req.GetEntity().GetExternalId(),
+		PageSize:         req.GetPageSize(),
+		ObjectCount:      len(resp.GetSuccess().GetObjects()),
+		Outcome:          outcome,
+		DurationMillis:   time.Since(start).Milliseconds(),
+	}
+}
+
+// FileAuditSink is an AuditSink writing records as JSON Lines into a file.
+//
+// Once writing a record would make the file larger than maxBytes, the file is
+// rotated: it is renamed with a ".1" suffix, previous backups are shifted to
+// ".2", ".3", etc., backups beyond maxBackups are deleted, and a new file is
+// created.
+type FileAuditSink struct {
+	path       string
+	maxBytes   int64
+	maxBackups int
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	file *os.File
+	size int64
+}
+
+// NewFileAuditSink returns a FileAuditSink appending to the file at the given
+// path, rotated once larger than maxBytes, and keeping up to maxBackups rotated
+// files. If maxBytes is not positive, the file is never rotated.
+// Returns an error if the file is rotated and maxBackups is less than 1, since
+// rotating the file would then discard all the records.
+func NewFileAuditSink(path string, maxBytes int64, maxBackups int) (*FileAuditSink, error) {
+	if maxBytes > 0 && maxBackups < 1 {
+		return nil, fmt.Errorf("invalid maximum number of audit file backups: %d, must be at least 1", maxBackups)
+	}
+
+	sink := &FileAuditSink{
+		path:       path,
+		maxBytes:   maxBytes,
+		maxBackups: maxBackups,
+	}
+
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// Audit appends the given record to the file.
+func (s *FileAuditSink) Audit(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the audit record: %w", err)
+	}
+
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("audit file %s is closed", s.path)
+	}
+
+	var rotateErr error
+
+	if s.maxBytes > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxBytes {
+		// The record is still written if the rotation failed, as long as the
+		// file could be reopened.
+		if rotateErr = s.rotate(); s.file == nil {
+			return rotateErr
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+
+	if err != nil {
+		return errors.Join(rotateErr, fmt.Errorf("failed to write the audit record to %s: %w", s.path, err))
+	}
+
+	return rotateErr
+}
+
+// Close closes the file.
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	return err
+}
+
+// open opens the file for appending.
+// s.mu must be locked, unless s is being created.
+func (s *FileAuditSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the audit file %s: %w", s.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return fmt.Errorf("failed to stat the audit file %s: %w", s.path, err)
+	}
+
+	s.file = file
+	s.size = info.Size()
+
+	return nil
+}
+
+// rotate renames the file and its backups, and opens a new file.
+// If the file cannot be rotated, the file is reopened so that records are
+// still appended to it, and the rotation error is returned.
+// s.file is nil after rotate returns only if the file cannot be reopened.
+// s.mu must be locked.
+func (s *FileAuditSink) rotate() error {
+	rotateErr := s.file.Close()
+	if rotateErr != nil {
+		rotateErr = fmt.Errorf("failed to close the audit file %s: %w", s.path, rotateErr)
+	} else {
+		rotateErr = s.renameFiles()
+	}
+
+	s.file = nil
+
+	if err := s.open(); err != nil {
+		return errors.Join(rotateErr, err)
+	}
+
+	return rotateErr
+}
+
+// renameFiles renames the closed file and its backups.
+func (s *FileAuditSink) renameFiles() error {
+	if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove the audit file backup: %w", err)
+	}
+
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate the audit file backups: %w", err)
+		}
+	}
+
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return fmt.Errorf("failed to rotate the audit file %s: %w", s.path, err)
+	}
+
+	return nil
+}
+
+// backupPath returns the path of the i-th most recent rotated file.
+func (s *FileAuditSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	grpc_metadata "google.golang.org/grpc/metadata"
+)
+
+// MockAuditSink collects the audit records.
+type MockAuditSink struct {
+	mu      sync.Mutex
+	Records []*AuditRecord
+}
+
+func (s *MockAuditSink) Audit(record *AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Records = append(s.Records, record)
+
+	return nil
+}
+
+func TestServer_GetPage_Audit(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	sink := &MockAuditSink{}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+		AuditSink:           sink,
+	}
+
+	if err := RegisterAdapter(s, "Mock-1.0.0", &MockLifecycleAdapter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	defer s.Close()
+
+	req := newTestResourceRequest("datasource-1", "Bearer secret")
+	req.TenantId = "tenant-1"
+	req.ClientId = "client-1"
+	req.Cursor = "cursor-1"
+
+	if _, err := s.GetPage(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	unsupportedReq := newTestResourceRequest("datasource-2", "Bearer secret")
+	unsupportedReq.Datasource.Type = "Unknown-1.0.0"
+
+	if _, err := s.GetPage(ctx, unsupportedReq); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unauthenticated requests are rejected before reaching the sink.
+	if _, err := s.GetPage(context.Background(), req); err == nil {
+		t.Fatal("Expected an unauthenticated request to fail")
+	}
+
+	if len(sink.Records) != 2 {
+		t.Fatalf("Expected 2 audit records, got %d", len(sink.Records))
+	}
+
+	for _, record := range sink.Records {
+		record.Time = time.Time{}
+		record.DurationMillis = 0
+	}
+
+	tokenIdentity := getTokenIdentity(validTokens[0])
+
+	AssertDeepEqual(t, []*AuditRecord{
+		{
+			TokenIdentity:    tokenIdentity,
+			TenantID:         "tenant-1",
+			ClientID:         "client-1",
+			DatasourceID:     "datasource-1",
+			DatasourceType:   "Mock-1.0.0",
+			EntityID:         "entity-abc",
+			EntityExternalID: "users",
+			PageSize:         10,
+			ObjectCount:      1,
+			Outcome:          AuditOutcomeSuccess,
+		},
+		{
+			TokenIdentity:    tokenIdentity,
+			DatasourceID:     "datasource-2",
+			DatasourceType:   "Unknown-1.0.0",
+			EntityID:         "entity-abc",
+			EntityExternalID: "users",
+			PageSize:         10,
+			Outcome:          "ERROR_CODE_INVALID_DATASOURCE_CONFIG",
+		},
+	}, sink.Records)
+
+	if strings.Contains(tokenIdentity, validTokens[0]) {
+		t.Errorf("Expected the token identity not to contain the token, got %s", tokenIdentity)
+	}
+}
+
+func TestFileAuditSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	record := &AuditRecord{
+		TokenIdentity:  "sha256:0123456789abcdef",
+		DatasourceID:   "datasource-1",
+		DatasourceType: "Mock-1.0.0",
+		Outcome:        AuditOutcomeSuccess,
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each file fits 2 records.
+	sink, err := NewFileAuditSink(path, int64(2*(len(line)+1)), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 7 {
+		if err := sink.Audit(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		path      string
+		wantLines int
+	}{
+		"current": {
+			path:      path,
+			wantLines: 1,
+		},
+		"backup_1": {
+			path:      path + ".1",
+			wantLines: 2,
+		},
+		"backup_2": {
+			path:      path + ".2",
+			wantLines: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+			if len(lines) != tt.wantLines {
+				t.Fatalf("Expected %d lines, got %d", tt.wantLines, len(lines))
+			}
+
+			for _, l := range lines {
+				var got AuditRecord
+				if err := json.Unmarshal([]byte(l), &got); err != nil {
+					t.Fatal(err)
+				}
+
+				AssertDeepEqual(t, *record, got)
+			}
+		})
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("Expected at most 2 backups, got %v", err)
+	}
+
+	if err := sink.Audit(record); err == nil {
+		t.Error("Expected an error after Close")
+	}
+}
+
+func TestFileAuditSink_RotateFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	// The backup path is a non-empty directory, so the audit file cannot be
+	// rotated to it.
+	if err := os.MkdirAll(filepath.Join(path+".1", "dir"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	record := &AuditRecord{
+		DatasourceID: "datasource-1",
+		Outcome:      AuditOutcomeSuccess,
+	}
+
+	sink, err := NewFileAuditSink(path, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	if err := sink.Audit(record); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rotation fails, but the records are still appended to the file.
+	for range 2 {
+		if err := sink.Audit(record); err == nil || !strings.Contains(err.Error(), "failed to") {
+			t.Errorf("Expected a rotation error, got %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertDeepEqual(t, 3, strings.Count(string(data), "\n"))
+}
+
+func TestNewFileAuditSink_NoBackups(t *testing.T) {
+	tests := map[string]struct {
+		maxBytes   int64
+		maxBackups int
+		wantErr    bool
+	}{
+		"rotated_without_backups": {
+			maxBytes:   1024,
+			maxBackups: 0,
+			wantErr:    true,
+		},
+		"rotated_with_negative_backups": {
+			maxBytes:   1024,
+			maxBackups: -1,
+			wantErr:    true,
+		},
+		"not_rotated_without_backups": {
+			maxBytes:   0,
+			maxBackups: 0,
+		},
+		"rotated_with_backup": {
+			maxBytes:   1024,
+			maxBackups: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sink, err := NewFileAuditSink(filepath.Join(t.TempDir(), "audit.jsonl"), tc.maxBytes, tc.maxBackups)
+
+			if tc.wantErr {
+				if err == nil {
+					sink.Close()
+					t.Fatal("Expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sink.Close()
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/logs"
+)
+
+// Default circuit breaker thresholds, used for the zero fields of a
+// CircuitBreakerConfig.
+const (
+	DefaultCircuitFailureThreshold = 5
+	DefaultCircuitOpenDuration     = 30 * time.Second
+	DefaultCircuitHalfOpenProbes   = 1
+)
+
+// CircuitBreakerConfig configures a circuit breaker.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures after which the
+	// circuit of a datasource opens.
+	FailureThreshold int
+
+	// OpenDuration is the duration during which requests fail fast once the
+	// circuit of a datasource opens, before probe requests are let through.
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the maximum number of concurrent probe requests let
+	// through once OpenDuration has elapsed.
+	HalfOpenProbes int
+}
+
+// circuitState is the state of the circuit of a datasource.
+type circuitState string
+
+const (
+	circuitClosed   circuitState = "closed"
+	circuitOpen     circuitState = "open"
+	circuitHalfOpen circuitState = "half-open"
+)
+
+// circuitOutcome is the outcome of a request let through a circuit.
+type circuitOutcome int
+
+const (
+	// circuitSuccess indicates that the datasource responded.
+	circuitSuccess circuitOutcome = iota
+
+	// circuitFailure indicates that the datasource is unavailable or failed.
+	circuitFailure
+
+	// circuitIgnored indicates that the request was canceled by the caller,
+	// which says nothing about the datasource.
+	circuitIgnored
+)
+
+// circuitKey identifies a datasource.
+type circuitKey struct {
+	datasourceType string
+	address        string
+}
+
+// circuit is the circuit of a datasource.
+type circuit struct {
+	state circuitState
+
+	// failures is the number of consecutive failures.
+	failures int
+
+	// openUntil is the time after which probe requests are let through, if
+	// the circuit is open.
+	openUntil time.Time
+
+	// probes is the number of probe requests in progress, if the circuit is
+	// half-open.
+	probes int
+}
+
+// CircuitBreaker fails GetPage requests fast for datasources that failed
+// repeatedly, instead of letting every request wait for the datasource to
+// time out.
+//
+// Circuits are keyed by datasource type and address. A circuit opens after
+// FailureThreshold consecutive requests failed with a
+// DATASOURCE_TEMPORARILY_UNAVAILABLE or DATASOURCE_FAILED error or timed out.
+// While open, requests fail with a DATASOURCE_TEMPORARILY_UNAVAILABLE error
+// with a RetryAfter. After OpenDuration, the circuit is half-open: up to
+// HalfOpenProbes requests are let through, and the circuit closes if one
+// succeeds or opens again if one fails.
+type CircuitBreaker struct {
+	config CircuitBreakerConfig
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// circuits contains the circuits of the datasources with failures. Other
+	// datasources' circuits are closed.
+	circuits map[circuitKey]*circuit
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// NewCircuitBreaker returns a CircuitBreaker with the given config, using the
+// default thresholds for its zero fields.
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = DefaultCircuitFailureThreshold
+	}
+
+	if config.OpenDuration <= 0 {
+		config.OpenDuration = DefaultCircuitOpenDuration
+	}
+
+	if config.HalfOpenProbes <= 0 {
+		config.HalfOpenProbes = DefaultCircuitHalfOpenProbes
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[circuitKey]*circuit),
+		now:      time.Now,
+	}
+}
+
+// Allow returns whether a request to the datasource with the given type and
+// address is let through.
+// If allowed, done must be called with the outcome of the request.
+// Otherwise, retryAfter is the duration after which requests may be let
+// through again.
+// State transitions are logged using the given logger, which may be nil.
+func (b *CircuitBreaker) Allow(
+	datasourceType, address string,
+	logger logs.Logger,
+) (done func(outcome circuitOutcome), retryAfter time.Duration, allowed bool) {
+	key := circuitKey{datasourceType: datasourceType, address: address}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, found := b.circuits[key]
+	if !found {
+		return b.doneFunc(key, false, logger), 0, true
+	}
+
+	now := b.now()
+
+	if c.state == circuitOpen {
+		if now.Before(c.openUntil) {
+			return nil, c.openUntil.Sub(now), false
+		}
+
+		b.transition(c, circuitHalfOpen, logger)
+	}
+
+	if c.state == circuitHalfOpen {
+		if c.probes >= b.config.HalfOpenProbes {
+			return nil, b.config.OpenDuration, false
+		}
+
+		c.probes++
+
+		return b.doneFunc(key, true, logger), 0, true
+	}
+
+	return b.doneFunc(key, false, logger), 0, true
+}
+
+// doneFunc returns a function recording the outcome of a request let through
+// the circuit of the given datasource.
+func (b *CircuitBreaker) doneFunc(key circuitKey, probe bool, logger logs.Logger) func(outcome circuitOutcome) {
+	var once sync.Once
+
+	return func(outcome circuitOutcome) {
+		once.Do(func() {
+			b.record(key, probe, outcome, logger)
+		})
+	}
+}
+
+// record records the outcome of a request let through the circuit of the given
+// datasource.
+func (b *CircuitBreaker) record(key circuitKey, probe bool, outcome circuitOutcome, logger logs.Logger) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, found := b.circuits[key]
+
+	if probe && found && c.state == circuitHalfOpen {
+		c.probes--
+	}
+
+	switch outcome {
+	case circuitSuccess:
+		if !found {
+			return
+		}
+
+		if c.state != circuitClosed {
+			b.transition(c, circuitClosed, logger)
+		}
+
+		delete(b.circuits, key)
+	case circuitFailure:
+		if !found {
+			c = &circuit{state: circuitClosed}
+			b.circuits[key] = c
+		}
+
+		c.failures++
+
+		switch {
+		case c.state == circuitHalfOpen && probe:
+			b.open(c, logger)
+		case c.state == circuitClosed && c.failures >= b.config.FailureThreshold:
+			b.open(c, logger)
+		}
+	}
+}
+
+// open opens the given circuit.
+// b.mu must be locked.
+func (b *CircuitBreaker) open(c *circuit, logger logs.Logger) {
+	c.openUntil = b.now().Add(b.config.OpenDuration)
+	b.transition(c, circuitOpen, logger)
+}
+
+// transition sets the state of the given circuit and logs the transition.
+// b.mu must be locked.
+func (b *CircuitBreaker) transition(c *circuit, state circuitState, logger logs.Logger) {
+	c.state = state
+
+	if logger != nil {
+		logger.Info("Datasource circuit breaker state changed",
+			logs.CircuitState(string(state)),
+			logs.ConsecutiveFailures(c.failures),
+		)
+	}
+}
+
+// getCircuitOutcome returns the outcome of a GetPage request with the given
+// context and response, from the point of view of a circuit breaker.
+func getCircuitOutcome(ctx context.Context, resp *framework.Response) circuitOutcome {
+	switch err := ctx.Err(); {
+	case errors.Is(err, context.DeadlineExceeded):
+		return circuitFailure
+	case err != nil:
+		return circuitIgnored
+	}
+
+	if resp.Error == nil {
+		return circuitSuccess
+	}
+
+	switch resp.Error.Code {
+	case api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED:
+		return circuitFailure
+	default:
+		return circuitSuccess
+	}
+}
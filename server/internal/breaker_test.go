@@ -0,0 +1,225 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/logs"
+	grpc_metadata "google.golang.org/grpc/metadata"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+	})
+
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	logger := logs.NewMockLogger()
+
+	request := func(address string, outcome circuitOutcome) (time.Duration, bool) {
+		done, retryAfter, allowed := breaker.Allow("Mock-1.0.0", address, logger)
+		if allowed {
+			done(outcome)
+		}
+
+		return retryAfter, allowed
+	}
+
+	assertAllowed := func(address string, outcome circuitOutcome, wantRetryAfter time.Duration, wantAllowed bool) {
+		t.Helper()
+
+		retryAfter, allowed := request(address, outcome)
+		if allowed != wantAllowed || retryAfter != wantRetryAfter {
+			t.Fatalf("Expected allowed %v with retry after %v, got %v with %v", wantAllowed, wantRetryAfter, allowed, retryAfter)
+		}
+	}
+
+	// Successes and non-consecutive failures don't open the circuit.
+	assertAllowed("a", circuitFailure, 0, true)
+	assertAllowed("a", circuitSuccess, 0, true)
+	assertAllowed("a", circuitFailure, 0, true)
+	assertAllowed("a", circuitIgnored, 0, true)
+
+	// The second consecutive failure opens the circuit.
+	assertAllowed("a", circuitFailure, 0, true)
+	assertAllowed("a", circuitSuccess, time.Minute, false)
+
+	// Other addresses are not affected.
+	assertAllowed("b", circuitSuccess, 0, true)
+
+	now = now.Add(40 * time.Second)
+	assertAllowed("a", circuitSuccess, 20*time.Second, false)
+
+	// Once half-open, a single probe is let through at a time.
+	now = now.Add(20 * time.Second)
+
+	done, _, allowed := breaker.Allow("Mock-1.0.0", "a", logger)
+	if !allowed {
+		t.Fatal("Expected a probe to be allowed")
+	}
+
+	assertAllowed("a", circuitSuccess, time.Minute, false)
+
+	// A failed probe opens the circuit again.
+	done(circuitFailure)
+	done(circuitSuccess) // Calling done twice is a no-op.
+
+	assertAllowed("a", circuitSuccess, time.Minute, false)
+
+	// A successful probe closes the circuit.
+	now = now.Add(time.Minute)
+	assertAllowed("a", circuitSuccess, 0, true)
+	assertAllowed("a", circuitFailure, 0, true)
+	assertAllowed("a", circuitSuccess, 0, true)
+
+	var states []any
+	for _, entry := range logger.Entries() {
+		states = append(states, entry.Fields[0].Value)
+	}
+
+	AssertDeepEqual(t, []any{"open", "half-open", "open", "half-open", "closed"}, states)
+}
+
+func TestGetCircuitOutcome(t *testing.T) {
+	timedOutCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := map[string]struct {
+		ctx  context.Context
+		resp framework.Response
+		want circuitOutcome
+	}{
+		"success": {
+			ctx:  context.Background(),
+			resp: framework.NewGetPageResponseSuccess(&framework.Page{}),
+			want: circuitSuccess,
+		},
+		"temporarily_unavailable": {
+			ctx: context.Background(),
+			resp: framework.NewGetPageResponseError(&framework.Error{
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			}),
+			want: circuitFailure,
+		},
+		"datasource_failed": {
+			ctx: context.Background(),
+			resp: framework.NewGetPageResponseError(&framework.Error{
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}),
+			want: circuitFailure,
+		},
+		"authentication_failed": {
+			ctx: context.Background(),
+			resp: framework.NewGetPageResponseError(&framework.Error{
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			}),
+			want: circuitSuccess,
+		},
+		"timed_out": {
+			ctx:  timedOutCtx,
+			resp: framework.NewGetPageResponseSuccess(&framework.Page{}),
+			want: circuitFailure,
+		},
+		"canceled": {
+			ctx: canceledCtx,
+			resp: framework.NewGetPageResponseError(&framework.Error{
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			}),
+			want: circuitIgnored,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			AssertDeepEqual(t, tc.want, getCircuitOutcome(tc.ctx, &tc.resp))
+		})
+	}
+}
+
+// MockFailingAdapter fails every request, and counts them.
+type MockFailingAdapter struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (a *MockFailingAdapter) GetPage(ctx context.Context, request *framework.Request[TestConfigA]) framework.Response {
+	a.mu.Lock()
+	a.calls++
+	a.mu.Unlock()
+
+	return framework.NewGetPageResponseError(&framework.Error{
+		Message: "Connection refused.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+	})
+}
+
+func TestServer_GetPage_CircuitBreaker(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	adapter := &MockFailingAdapter{}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+		CircuitBreaker:      &CircuitBreakerConfig{FailureThreshold: 5},
+	}
+
+	err := RegisterAdapter(s, "Mock-1.0.0", adapter, func(opts *AdapterOptions) {
+		opts.CircuitBreaker = &CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Minute}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := newTestResourceRequest("datasource-1", "Bearer v1")
+
+	for range 2 {
+		resp, _ := s.GetPage(ctx, req)
+		if resp.GetError().GetCode() != api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED {
+			t.Fatalf("Expected the adapter error, got %v", resp)
+		}
+	}
+
+	resp, _ := s.GetPage(ctx, req)
+
+	gotErr := resp.GetError()
+	if gotErr.GetCode() != api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE {
+		t.Fatalf("Expected a fail-fast error, got %v", resp)
+	}
+
+	if d := gotErr.GetRetryAfter().AsDuration(); d <= 0 || d > time.Minute {
+		t.Errorf("Expected a retry after within the open duration, got %v", d)
+	}
+
+	if adapter.calls != 2 {
+		t.Errorf("Expected 2 adapter calls, got %d", adapter.calls)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"sync"
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestKey identifies a GetPage request, excluding the datasource credentials.
+type requestKey [sha256.Size]byte
+
+// credentialsKey identifies the datasource credentials of a GetPage request.
+type credentialsKey [sha256.Size]byte
+
+// getRequestKey returns the hash of the datasource ID, type, address, config
+// and connector info, entity config, page size, cursor, tenant ID, client ID
+// and child page request of the given request.
+// The datasource credentials are excluded from the key.
+func getRequestKey(req *api_adapter_v1.GetPageRequest) (key requestKey) {
+	h := sha256.New()
+
+	writeHashField(h, []byte(req.GetDatasource().GetId()))
+	writeHashField(h, []byte(req.GetDatasource().GetType()))
+	writeHashField(h, []byte(req.GetDatasource().GetAddress()))
+	writeHashField(h, req.GetDatasource().GetConfig())
+	writeHashField(h, marshalDeterministic(req.GetEntity()))
+	writeHashField(h, binary.BigEndian.AppendUint64(nil, uint64(req.PageSize)))
+	writeHashField(h, []byte(req.Cursor))
+	writeHashField(h, []byte(req.TenantId))
+	writeHashField(h, []byte(req.ClientId))
+	writeHashField(h, marshalDeterministic(req.GetDatasource().GetConnectorInfo()))
+	writeHashField(h, marshalDeterministic(req.GetChildPage()))
+
+	h.Sum(key[:0])
+
+	return
+}
+
+// getCredentialsKey returns the hash of the datasource credentials of the
+// given request.
+func getCredentialsKey(req *api_adapter_v1.GetPageRequest) (key credentialsKey) {
+	h := sha256.New()
+
+	writeHashField(h, marshalDeterministic(req.GetDatasource().GetAuth()))
+
+	h.Sum(key[:0])
+
+	return
+}
+
+// writeHashField writes the given field into h, prefixed with its length so
+// that the concatenation of fields is unambiguous.
+func writeHashField(h hash.Hash, field []byte) {
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(field))))
+	h.Write(field)
+}
+
+// marshalDeterministic marshals the given message with a deterministic
+// ordering of map entries, or returns nil if it cannot be marshaled.
+func marshalDeterministic(m proto.Message) []byte {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// ResponseCache is a bounded in-memory cache of GetPage responses, used to
+// avoid calling the datasource again when the ingestion service retries an
+// identical GetPage request.
+//
+// Responses are cached for a short TTL and are only returned to requests
+// that carry the same datasource credentials as the request that produced
+// them. Concurrent identical requests are collapsed into a single adapter
+// call. Error responses are cached only if they are not transient.
+type ResponseCache struct {
+	maxEntries int
+	ttl        time.Duration
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// entries maps each request key to its element in lru.
+	entries map[requestKey]*list.Element
+
+	// lru contains the cached *responseCacheEntry values, the most recently
+	// used first.
+	lru *list.List
+
+	// calls contains the adapter calls in progress.
+	calls map[inflightKey]*inflightCall
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// responseCacheEntry is a response cached for a request.
+type responseCacheEntry struct {
+	key         requestKey
+	credentials credentialsKey
+	resp        *api_adapter_v1.GetPageResponse
+	expiresAt   time.Time
+}
+
+// inflightKey identifies an adapter call in progress.
+type inflightKey struct {
+	request     requestKey
+	credentials credentialsKey
+}
+
+// inflightCall is an adapter call in progress, which result is shared by all
+// the identical requests received until it completes.
+type inflightCall struct {
+	done chan struct{}
+	resp *api_adapter_v1.GetPageResponse
+}
+
+// NewResponseCache returns a ResponseCache that contains at most maxEntries
+// responses, each expiring after the given TTL.
+func NewResponseCache(maxEntries int, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		entries:    make(map[requestKey]*list.Element),
+		lru:        list.New(),
+		calls:      make(map[inflightKey]*inflightCall),
+		now:        time.Now,
+	}
+}
+
+// Do returns the cached response for the given request if any, or calls
+// getPage and caches its response otherwise.
+// If an identical request is already in progress, Do waits for its response
+// instead of calling getPage, or until ctx is done.
+func (c *ResponseCache) Do(
+	ctx context.Context,
+	req *api_adapter_v1.GetPageRequest,
+	getPage func() (resp *api_adapter_v1.GetPageResponse, cacheable bool),
+) *api_adapter_v1.GetPageResponse {
+	key := inflightKey{
+		request:     getRequestKey(req),
+		credentials: getCredentialsKey(req),
+	}
+
+	c.mu.Lock()
+
+	if resp := c.get(key); resp != nil {
+		c.mu.Unlock()
+
+		return resp
+	}
+
+	if call, found := c.calls[key]; found {
+		c.mu.Unlock()
+
+		select {
+		case <-call.done:
+			return call.resp
+		case <-ctx.Done():
+			return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: "Request canceled while waiting for an identical request in progress.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			})
+		}
+	}
+
+	call := &inflightCall{done: make(chan struct{})}
+	c.calls[key] = call
+
+	c.mu.Unlock()
+
+	var cacheable bool
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+
+		if cacheable && isCacheableResponse(call.resp) {
+			c.add(key, call.resp)
+		}
+
+		c.mu.Unlock()
+
+		close(call.done)
+	}()
+
+	call.resp, cacheable = getPage()
+
+	return call.resp
+}
+
+// get returns the unexpired response cached for the given key, or nil.
+// c.mu must be locked.
+func (c *ResponseCache) get(key inflightKey) *api_adapter_v1.GetPageResponse {
+	elem, found := c.entries[key.request]
+	if !found {
+		return nil
+	}
+
+	entry := elem.Value.(*responseCacheEntry)
+
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(elem)
+
+		return nil
+	}
+
+	// Never return a response to a request authenticated with different
+	// credentials than the request that produced it.
+	if entry.credentials != key.credentials {
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return entry.resp
+}
+
+// add caches the given response for the given key, evicting the least
+// recently used entries if the cache is full.
+// c.mu must be locked.
+func (c *ResponseCache) add(key inflightKey, resp *api_adapter_v1.GetPageResponse) {
+	if elem, found := c.entries[key.request]; found {
+		c.remove(elem)
+	}
+
+	c.entries[key.request] = c.lru.PushFront(&responseCacheEntry{
+		key:         key.request,
+		credentials: key.credentials,
+		resp:        resp,
+		expiresAt:   c.now().Add(c.ttl),
+	})
+
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove removes the given element from the cache.
+// c.mu must be locked.
+func (c *ResponseCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*responseCacheEntry).key)
+}
+
+// isCacheableResponse returns true if the given response is a successful
+// response or an error that would not be resolved by retrying the request.
+func isCacheableResponse(resp *api_adapter_v1.GetPageResponse) bool {
+	if resp == nil {
+		return false
+	}
+
+	adapterErr := resp.GetError()
+
+	return adapterErr == nil || !isTransientError(adapterErr)
+}
+
+// isTransientError returns true if the given error may not occur again if the
+// request is retried.
+func isTransientError(adapterErr *api_adapter_v1.Error) bool {
+	if adapterErr.Transient {
+		return true
+	}
+
+	if adapterErr.RetryAfter != nil {
+		return true
+	}
+
+	switch adapterErr.Code {
+	case api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+		api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED:
+		return true
+	default:
+		return false
+	}
+}
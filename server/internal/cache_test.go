@@ -0,0 +1,238 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+func newTestCacheRequest(cursor, authorization string) *api_adapter_v1.GetPageRequest {
+	return &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:      "1f530a64-0565-49e6-8647-b88e908b7229",
+			Type:    "Mock-1.0.1",
+			Config:  []byte(`{"a":"a value"}`),
+			Address: "http://example.com/",
+			Auth: &api_adapter_v1.DatasourceAuthCredentials{
+				AuthMechanism: &api_adapter_v1.DatasourceAuthCredentials_HttpAuthorization{
+					HttpAuthorization: authorization,
+				},
+			},
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 100,
+		Cursor:   cursor,
+	}
+}
+
+func TestResponseCache_Do(t *testing.T) {
+	cache := NewResponseCache(2, time.Minute)
+
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	var calls int
+
+	getPage := func(nextCursor string) func() (*api_adapter_v1.GetPageResponse, bool) {
+		return func() (*api_adapter_v1.GetPageResponse, bool) {
+			calls++
+
+			return api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: nextCursor}), true
+		}
+	}
+
+	first := cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), getPage("1"))
+	second := cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), getPage("2"))
+
+	AssertDeepEqual(t, 1, calls)
+	AssertDeepEqual(t, "1", second.GetSuccess().NextCursor)
+
+	if first != second {
+		t.Error("Expected the cached response to be returned")
+	}
+
+	// A request with different credentials must not get the cached response.
+	third := cache.Do(context.Background(), newTestCacheRequest("", "Bearer b"), getPage("3"))
+
+	AssertDeepEqual(t, 2, calls)
+	AssertDeepEqual(t, "3", third.GetSuccess().NextCursor)
+
+	// A request for a different cursor is a different request.
+	cache.Do(context.Background(), newTestCacheRequest("1", "Bearer b"), getPage("4"))
+	cache.Do(context.Background(), newTestCacheRequest("2", "Bearer b"), getPage("5"))
+
+	AssertDeepEqual(t, 4, calls)
+	AssertDeepEqual(t, 2, cache.lru.Len())
+
+	// The least recently used entry was evicted.
+	cache.Do(context.Background(), newTestCacheRequest("", "Bearer b"), getPage("6"))
+
+	AssertDeepEqual(t, 5, calls)
+
+	// Entries expire after the TTL.
+	now = now.Add(time.Minute)
+
+	cache.Do(context.Background(), newTestCacheRequest("2", "Bearer b"), getPage("7"))
+
+	AssertDeepEqual(t, 6, calls)
+}
+
+func TestResponseCache_Do_Errors(t *testing.T) {
+	tests := map[string]struct {
+		resp      *api_adapter_v1.GetPageResponse
+		cacheable bool
+		wantCalls int
+	}{
+		"permanent_error": {
+			resp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: "Failed to authenticate with datasource.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+			}),
+			cacheable: true,
+			wantCalls: 1,
+		},
+		"transient_error": {
+			resp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: "Datasource is temporarily unavailable.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			}),
+			cacheable: true,
+			wantCalls: 2,
+		},
+		"transient_error_with_permanent_code": {
+			resp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message:   "Failed to connect to the datasource.",
+				Code:      api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				Transient: true,
+			}),
+			cacheable: true,
+			wantCalls: 2,
+		},
+		"not_cacheable": {
+			resp:      api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{}),
+			cacheable: false,
+			wantCalls: 2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cache := NewResponseCache(10, time.Minute)
+
+			var calls int
+
+			getPage := func() (*api_adapter_v1.GetPageResponse, bool) {
+				calls++
+
+				return tc.resp, tc.cacheable
+			}
+
+			cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), getPage)
+			cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), getPage)
+
+			AssertDeepEqual(t, tc.wantCalls, calls)
+		})
+	}
+}
+
+func TestResponseCache_Do_Concurrent(t *testing.T) {
+	cache := NewResponseCache(10, time.Minute)
+
+	var calls atomic.Int32
+
+	release := make(chan struct{})
+
+	getPage := func() (*api_adapter_v1.GetPageResponse, bool) {
+		calls.Add(1)
+		<-release
+
+		// Transient errors are not cached, but are still shared by the
+		// concurrent identical requests.
+		return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+			Code: api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+		}), true
+	}
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp := cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), getPage)
+			if resp.GetError() == nil {
+				t.Errorf("Expected an error response, got %v", resp)
+			}
+		}()
+	}
+
+	// Let all the goroutines wait for the in-progress call.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	AssertDeepEqual(t, int32(1), calls.Load())
+	AssertDeepEqual(t, 0, cache.lru.Len())
+}
+
+func TestResponseCache_Do_WaiterCanceled(t *testing.T) {
+	cache := NewResponseCache(10, time.Minute)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go cache.Do(context.Background(), newTestCacheRequest("", "Bearer a"), func() (*api_adapter_v1.GetPageResponse, bool) {
+		close(started)
+		<-release
+
+		return api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{}), true
+	})
+
+	defer close(release)
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	resp := cache.Do(ctx, newTestCacheRequest("", "Bearer a"), func() (*api_adapter_v1.GetPageResponse, bool) {
+		t.Error("Unexpected call for an identical request in progress")
+
+		return nil, false
+	})
+
+	AssertDeepEqual(t, api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+		Message: "Request canceled while waiting for an identical request in progress.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+	}), resp)
+}
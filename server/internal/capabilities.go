@@ -0,0 +1,84 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+// GetCapabilities returns the capabilities of the registered high-level Adapter
+// implementations.
+func (s *Server) GetCapabilities(
+	ctx context.Context,
+	req *api_adapter_v1.GetCapabilitiesRequest,
+) (*api_adapter_v1.GetCapabilitiesResponse, error) {
+	if err := s.validateAuthenticationToken(ctx); err != nil {
+		return nil, err
+	}
+
+	datasourceTypes := slices.Clone(req.GetDatasourceTypes())
+	if len(datasourceTypes) == 0 {
+		datasourceTypes = slices.Collect(maps.Keys(s.AdapterCapabilities))
+	}
+
+	slices.Sort(datasourceTypes)
+	datasourceTypes = slices.Compact(datasourceTypes)
+
+	resp := &api_adapter_v1.GetCapabilitiesResponse{}
+
+	for _, datasourceType := range datasourceTypes {
+		if capabilities, ok := s.AdapterCapabilities[datasourceType]; ok {
+			resp.Datasources = append(resp.Datasources, capabilities)
+		}
+	}
+
+	return resp, nil
+}
+
+// getEntityCapabilities converts the entities declared by an Adapter into RPC
+// entity capabilities.
+func getEntityCapabilities(entities []framework.EntityInfo) []*api_adapter_v1.EntityCapabilities {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	capabilities := make([]*api_adapter_v1.EntityCapabilities, 0, len(entities))
+
+	for _, entity := range entities {
+		capabilities = append(capabilities, &api_adapter_v1.EntityCapabilities{
+			ExternalId: entity.ExternalID,
+			Pattern:    entity.Pattern,
+		})
+	}
+
+	return capabilities
+}
+
+// getConfigSchema returns the marshaled JSON Schema of the given Config type.
+func getConfigSchema[Config any]() ([]byte, error) {
+	schema, err := config.JSONSchema[Config]()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(schema)
+}
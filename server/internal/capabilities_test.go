@@ -0,0 +1,138 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/grpc/codes"
+	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServer_GetCapabilities(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+	}
+
+	if err := RegisterAdapter(s, "Mock-A", NewAdapterA(framework.Response{})); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterAdapter(s, "Mock-B", NewAdapterB(framework.Response{})); err != nil {
+		t.Fatal(err)
+	}
+
+	router := framework.NewRouter[TestConfigA]()
+	router.MustHandleFunc("users", nil)
+	router.MustHandlePatternFunc("custom_*", nil)
+
+	if err := RegisterAdapter(s, "Mock-Router", router); err != nil {
+		t.Fatal(err)
+	}
+
+	schemaA := []byte(`{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object",` +
+		`"properties":{"a":{"type":"string"},"b":{"type":"string"}}}`)
+	schemaB := []byte(`{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object",` +
+		`"properties":{"c":{"type":"string"},"d":{"type":"string"}}}`)
+
+	tests := map[string]struct {
+		tokens   []string
+		req      *api_adapter_v1.GetCapabilitiesRequest
+		wantResp *api_adapter_v1.GetCapabilitiesResponse
+		wantCode codes.Code
+	}{
+		"all_types": {
+			tokens: validTokens,
+			req:    &api_adapter_v1.GetCapabilitiesRequest{},
+			wantResp: &api_adapter_v1.GetCapabilitiesResponse{
+				Datasources: []*api_adapter_v1.DatasourceCapabilities{
+					{Type: "Mock-A", ConfigSchema: schemaA},
+					{Type: "Mock-B", ConfigSchema: schemaB},
+					{
+						Type:         "Mock-Router",
+						ConfigSchema: schemaA,
+						Entities: []*api_adapter_v1.EntityCapabilities{
+							{ExternalId: "users"},
+							{ExternalId: "custom_*", Pattern: true},
+						},
+					},
+				},
+			},
+		},
+		"requested_types": {
+			tokens: validTokens,
+			req: &api_adapter_v1.GetCapabilitiesRequest{
+				DatasourceTypes: []string{"Mock-B", "Unknown", "Mock-B"},
+			},
+			wantResp: &api_adapter_v1.GetCapabilitiesResponse{
+				Datasources: []*api_adapter_v1.DatasourceCapabilities{
+					{Type: "Mock-B", ConfigSchema: schemaB},
+				},
+			},
+		},
+		"invalid_token": {
+			tokens:   []string{"invalid"},
+			req:      &api_adapter_v1.GetCapabilitiesRequest{},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+				"token": tc.tokens,
+			})
+
+			gotResp, err := s.GetCapabilities(ctx, tc.req)
+
+			if got := status.Code(err); got != tc.wantCode {
+				t.Fatalf("gotCode: %v, wantCode: %v", got, tc.wantCode)
+			}
+
+			if !proto.Equal(gotResp, tc.wantResp) {
+				t.Errorf("gotResp: %v, wantResp: %v", gotResp, tc.wantResp)
+			}
+		})
+	}
+}
+
+func TestRegisterAdapter_InvalidConfigSchema(t *testing.T) {
+	type InvalidConfig struct {
+		PageSize int `json:"pageSize" default:"many"`
+	}
+
+	s := &Server{
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+	}
+
+	err := RegisterAdapter[InvalidConfig](s, "Mock-Invalid", nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	want := "failed to generate the config JSON Schema for datasource type Mock-Invalid: invalid struct tags for " +
+		"$.pageSize: invalid default value: invalid character 'm' looking for beginning of value"
+	if err.Error() != want {
+		t.Errorf("gotErr: %q, wantErr: %q", err.Error(), want)
+	}
+}
@@ -15,22 +15,80 @@
 package internal
 
 import (
-	"encoding/json"
+	"context"
+	"fmt"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
 )
 
 // ParseConfig parses a configuration for a datasource from the given marshaled
-// JSON object.
-func ParseConfig[Config any](data []byte) (*Config, error) {
-	if data == nil {
-		return nil, nil
+// JSON object, sets its default values and validates it.
+// See package config for the supported struct tags.
+func ParseConfig[Config any](data []byte, opts ...config.Option) (*Config, error) {
+	return config.Parse[Config](data, opts...)
+}
+
+// ValidateConfig validates a datasource config and returns it migrated to the
+// latest version supported by the registered high-level Adapter implementation.
+func (s *Server) ValidateConfig(
+	ctx context.Context,
+	req *api_adapter_v1.ValidateConfigRequest,
+) (*api_adapter_v1.ValidateConfigResponse, error) {
+	if err := s.validateAuthenticationToken(ctx); err != nil {
+		return nil, err
 	}
 
-	config := new(Config)
+	if req.GetDatasource() == nil {
+		return &api_adapter_v1.ValidateConfigResponse{
+			Error: &api_adapter_v1.Error{
+				Message: "Request contains no datasource config.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		}, nil
+	}
 
-	err := json.Unmarshal(data, config)
-	if err != nil {
-		return nil, err
+	validateConfigFunc, ok := s.AdapterValidateConfigFuncs[req.Datasource.Type]
+	if !ok {
+		return &api_adapter_v1.ValidateConfigResponse{
+			Error: &api_adapter_v1.Error{
+				Message: fmt.Sprintf("Unsupported datasource type provided: %s.", req.Datasource.Type),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			},
+		}, nil
+	}
+
+	return validateConfigFunc(ctx, req.Datasource), nil
+}
+
+// validateConfig migrates and validates the config of the given datasource.
+func validateConfig[Config any](
+	ctx context.Context,
+	datasource *api_adapter_v1.DatasourceConfig,
+	opts *requestOptions,
+) *api_adapter_v1.ValidateConfigResponse {
+	resp := &api_adapter_v1.ValidateConfigResponse{
+		ConfigVersion: opts.migrations.Version(),
+	}
+
+	if len(datasource.Config) == 0 {
+		return resp
 	}
 
-	return config, nil
+	var data []byte
+
+	data, resp.OriginalConfigVersion, resp.Error = migrateConfig(datasource.Config, opts.migrations)
+	if resp.Error != nil {
+		return resp
+	}
+
+	resp.Config = data
+
+	// The config is already migrated.
+	parseOpts := *opts
+	parseOpts.migrations = nil
+
+	_, resp.Error = getAdapterConfig[Config](ctx, data, &parseOpts)
+
+	return resp
 }
@@ -15,7 +15,14 @@
 package internal
 
 import (
+	"context"
 	"testing"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestParseConfig(t *testing.T) {
@@ -44,3 +51,121 @@ func TestParseConfig(t *testing.T) {
 		t.Errorf("Expected %v, got %v", "invalid character 'i' looking for beginning of value", err)
 	}
 }
+
+func TestServer_ValidateConfig(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+	}
+
+	// Version 1 renamed "name" into "a", version 2 added "b".
+	migrations := config.Migrations{
+		func(c map[string]any) error {
+			c["a"] = c["name"]
+			delete(c, "name")
+
+			return nil
+		},
+		func(c map[string]any) error {
+			c["b"] = "b value"
+
+			return nil
+		},
+	}
+
+	err := RegisterAdapter(s, "Mock-A", NewAdapterA(framework.Response{}), func(opts *AdapterOptions) {
+		opts.StrictConfig = true
+		opts.ConfigMigrations = migrations
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		datasource *api_adapter_v1.DatasourceConfig
+		wantResp   *api_adapter_v1.ValidateConfigResponse
+	}{
+		"migrated": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Type:   "Mock-A",
+				Config: []byte(`{"name":"a value"}`),
+			},
+			wantResp: &api_adapter_v1.ValidateConfigResponse{
+				Config:                []byte(`{"a":"a value","b":"b value","version":2}`),
+				OriginalConfigVersion: 0,
+				ConfigVersion:         2,
+			},
+		},
+		"latest": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Type:   "Mock-A",
+				Config: []byte(`{"a":"a value","b":"b","version":2}`),
+			},
+			wantResp: &api_adapter_v1.ValidateConfigResponse{
+				Config:                []byte(`{"a":"a value","b":"b","version":2}`),
+				OriginalConfigVersion: 2,
+				ConfigVersion:         2,
+			},
+		},
+		"invalid_after_migration": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Type:   "Mock-A",
+				Config: []byte(`{"a":"a value","c":"c value","version":1}`),
+			},
+			wantResp: &api_adapter_v1.ValidateConfigResponse{
+				Config:                []byte(`{"a":"a value","b":"b value","c":"c value","version":2}`),
+				OriginalConfigVersion: 1,
+				ConfigVersion:         2,
+				Error: &api_adapter_v1.Error{
+					Message: "Config in datasource config is invalid: $.c: unknown field.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+		"future_version": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Type:   "Mock-A",
+				Config: []byte(`{"version":3}`),
+			},
+			wantResp: &api_adapter_v1.ValidateConfigResponse{
+				OriginalConfigVersion: 3,
+				ConfigVersion:         2,
+				Error: &api_adapter_v1.Error{
+					Message: "Config in datasource config could not be migrated to version 2: " +
+						"config version 3 is more recent than the latest supported version 2.",
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+		"unsupported_type": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Type: "Unknown",
+			},
+			wantResp: &api_adapter_v1.ValidateConfigResponse{
+				Error: &api_adapter_v1.Error{
+					Message: "Unsupported datasource type provided: Unknown.",
+					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResp, err := s.ValidateConfig(ctx, &api_adapter_v1.ValidateConfigRequest{Datasource: tc.datasource})
+			if err != nil {
+				t.Fatalf("ValidateConfig returned error: %v", err)
+			}
+
+			if !proto.Equal(gotResp, tc.wantResp) {
+				t.Errorf("gotResp: %v, wantResp: %v", gotResp, tc.wantResp)
+			}
+		})
+	}
+}
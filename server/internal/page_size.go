@@ -0,0 +1,134 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	// Register the gzip compressor, so that it can be used for responses.
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// gzipCompressor is the name of the gzip compressor registered with gRPC.
+	gzipCompressor = "gzip"
+
+	// pageTooLargeReason is the reason of the error returned for a page larger
+	// than the maximum page size in bytes.
+	pageTooLargeReason = "PAGE_TOO_LARGE"
+)
+
+// pageSplitFunc returns the cursor of the page starting after the first n
+// objects of a page, and false if the page cannot be split.
+type pageSplitFunc func(n int) (nextCursor string, ok bool)
+
+// limitResponseSize returns the given response if its serialized size is at
+// most maxBytes, or if maxBytes is not positive.
+// Otherwise, returns a response with the first objects of the page that fit
+// and the cursor returned by split, if not nil, or an error suggesting a
+// smaller page size.
+func limitResponseSize(
+	resp *api_adapter_v1.GetPageResponse,
+	maxBytes int,
+	split pageSplitFunc,
+) *api_adapter_v1.GetPageResponse {
+	if maxBytes <= 0 {
+		return resp
+	}
+
+	size := proto.Size(resp)
+	page := resp.GetSuccess()
+
+	// Error responses are always small enough.
+	if size <= maxBytes || page == nil {
+		return resp
+	}
+
+	// Count the objects that fit in the budget, along with the rest of the page.
+	objectsSize := 0
+	for _, object := range page.Objects {
+		objectsSize += objectFieldSize(object)
+	}
+
+	n, fitSize := 0, size-objectsSize
+	for _, object := range page.Objects {
+		fitSize += objectFieldSize(object)
+		if fitSize > maxBytes {
+			break
+		}
+
+		n++
+	}
+
+	if n > 0 && split != nil {
+		if nextCursor, ok := split(n); ok {
+			splitResp := api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{
+				NextCursor: nextCursor,
+				Objects:    slices.Clip(page.Objects[:n]),
+				Warnings:   page.Warnings,
+			})
+
+			// The next cursor may be longer than the original one.
+			if proto.Size(splitResp) <= maxBytes {
+				return splitResp
+			}
+		}
+	}
+
+	if n == 0 {
+		return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+			Message: fmt.Sprintf("Page is too large to be returned: its first object alone exceeds the maximum page size of %d bytes.", maxBytes),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			Reason:  pageTooLargeReason,
+		})
+	}
+
+	return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+		Message: fmt.Sprintf("Page is too large to be returned: its size of %d bytes exceeds the maximum page size of %d bytes. "+
+			"Retry with a page size of at most %d.", size, maxBytes, n),
+		Code:              api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		Reason:            pageTooLargeReason,
+		SuggestedPageSize: int64(n),
+	})
+}
+
+// objectFieldSize returns the serialized size of the given object as an
+// element of the objects field of a page.
+func objectFieldSize(object *api_adapter_v1.Object) int {
+	// Objects is field 1 of Page.
+	return protowire.SizeTag(1) + protowire.SizeBytes(proto.Size(object))
+}
+
+// setGzipCompressor configures the response of the RPC of the given context to
+// be compressed with gzip, if the client supports it.
+func setGzipCompressor(ctx context.Context) error {
+	compressors, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(compressors, gzipCompressor) {
+		return nil
+	}
+
+	return grpc.SetSendCompressor(ctx, gzipCompressor)
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLimitResponseSize(t *testing.T) {
+	newObject := func(name string) *api_adapter_v1.Object {
+		return &api_adapter_v1.Object{
+			Attributes: []*api_adapter_v1.Attribute{
+				{
+					Id: "attr-1",
+					Values: []*api_adapter_v1.AttributeValue{
+						{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: name}},
+					},
+				},
+			},
+		}
+	}
+
+	// Each object is 100 bytes as an element of a page.
+	objects := make([]*api_adapter_v1.Object, 10)
+	for i := range objects {
+		objects[i] = newObject(fmt.Sprintf("%s%d", strings.Repeat("a", 83), i))
+	}
+
+	newPageResponse := func(objects []*api_adapter_v1.Object, nextCursor string) *api_adapter_v1.GetPageResponse {
+		return api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{
+			Objects:    objects,
+			NextCursor: nextCursor,
+		})
+	}
+
+	// 1006 bytes, including 6 bytes for the page field and the next cursor.
+	resp := newPageResponse(objects, "c")
+
+	splitAt := func(n int) (string, bool) {
+		return fmt.Sprintf("after-%d", n), true
+	}
+
+	tests := map[string]struct {
+		resp     *api_adapter_v1.GetPageResponse
+		maxBytes int
+		split    pageSplitFunc
+		wantResp *api_adapter_v1.GetPageResponse
+	}{
+		"no_limit": {
+			resp:     resp,
+			maxBytes: 0,
+			wantResp: resp,
+		},
+		"within_limit": {
+			resp:     resp,
+			maxBytes: 1006,
+			wantResp: resp,
+		},
+		"error_response": {
+			resp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: strings.Repeat("a", 100),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+			maxBytes: 10,
+			wantResp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: strings.Repeat("a", 100),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}),
+		},
+		"too_large": {
+			resp:     resp,
+			maxBytes: 1000,
+			wantResp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message:           "Page is too large to be returned: its size of 1006 bytes exceeds the maximum page size of 1000 bytes. Retry with a page size of at most 9.",
+				Code:              api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Reason:            "PAGE_TOO_LARGE",
+				SuggestedPageSize: 9,
+			}),
+		},
+		"split": {
+			resp:     resp,
+			maxBytes: 500,
+			split:    splitAt,
+			wantResp: newPageResponse(objects[:4], "after-4"),
+		},
+		"split_refused": {
+			resp:     resp,
+			maxBytes: 500,
+			split: func(n int) (string, bool) {
+				return "", false
+			},
+			wantResp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message:           "Page is too large to be returned: its size of 1006 bytes exceeds the maximum page size of 500 bytes. Retry with a page size of at most 4.",
+				Code:              api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Reason:            "PAGE_TOO_LARGE",
+				SuggestedPageSize: 4,
+			}),
+		},
+		"split_cursor_too_large": {
+			resp:     resp,
+			maxBytes: 406,
+			split: func(n int) (string, bool) {
+				return strings.Repeat("c", 100), true
+			},
+			wantResp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message:           "Page is too large to be returned: its size of 1006 bytes exceeds the maximum page size of 406 bytes. Retry with a page size of at most 4.",
+				Code:              api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Reason:            "PAGE_TOO_LARGE",
+				SuggestedPageSize: 4,
+			}),
+		},
+		"first_object_too_large": {
+			resp:     resp,
+			maxBytes: 50,
+			split:    splitAt,
+			wantResp: api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+				Message: "Page is too large to be returned: its first object alone exceeds the maximum page size of 50 bytes.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				Reason:  "PAGE_TOO_LARGE",
+			}),
+		},
+	}
+
+	if got := proto.Size(resp); got != 1006 {
+		t.Fatalf("Expected a response of 1006 bytes, got %d", got)
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotResp := limitResponseSize(tc.resp, tc.maxBytes, tc.split)
+
+			if !proto.Equal(tc.wantResp, gotResp) {
+				t.Errorf("Expected %v, got %v", tc.wantResp, gotResp)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Prefetcher speculatively gets the next page of an entity in the background
+// while the ingestion service processes the current page, and buffers the
+// response until that next page is requested.
+//
+// Buffered responses are evicted, the oldest first, when the total size of
+// the buffered responses exceeds the configured limit, or once they expire.
+// Prefetches in progress buffer nothing yet, so they are never evicted to free
+// space. Their duration is bounded by the TTL instead.
+//
+// At most maxInFlight prefetches run at the same time. A prefetch is skipped
+// if that limit is reached.
+type Prefetcher struct {
+	maxBytes    int64
+	maxInFlight int
+	ttl         time.Duration
+
+	// ctx is the parent context of all prefetches. Canceled by Close.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// entries maps each prefetched request to its element in lru.
+	entries map[inflightKey]*list.Element
+
+	// lru contains the *prefetchEntry values, the most recently started
+	// first.
+	lru *list.List
+
+	// bytes is the total size of the buffered responses.
+	bytes int64
+
+	// inFlight is the number of prefetches running, including the evicted
+	// prefetches that are canceled but have not returned yet.
+	inFlight int
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// prefetchEntry is a prefetch in progress or a buffered prefetched response.
+type prefetchEntry struct {
+	key    inflightKey
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	// resp, size and expiresAt are set once the prefetch is done.
+	resp      *api_adapter_v1.GetPageResponse
+	size      int64
+	expiresAt time.Time
+}
+
+// NewPrefetcher returns a Prefetcher that buffers prefetched responses up to
+// a total of maxBytes bytes, each for up to the given TTL after it completes,
+// and that runs at most maxInFlight prefetches at the same time.
+// The TTL also bounds the duration of each prefetch.
+func NewPrefetcher(maxBytes int64, maxInFlight int, ttl time.Duration) *Prefetcher {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Prefetcher{
+		maxBytes:    maxBytes,
+		maxInFlight: maxInFlight,
+		ttl:         ttl,
+		ctx:         ctx,
+		cancel:      cancel,
+		entries:     make(map[inflightKey]*list.Element),
+		lru:         list.New(),
+		now:         time.Now,
+	}
+}
+
+// Start starts getting the page for the given request in the background,
+// unless that page is already being prefetched or buffered, or the maximum
+// number of prefetches in flight is reached.
+func (p *Prefetcher) Start(
+	req *api_adapter_v1.GetPageRequest,
+	getPage func(ctx context.Context) *api_adapter_v1.GetPageResponse,
+) {
+	key := inflightKey{
+		request:     getRequestKey(req),
+		credentials: getCredentialsKey(req),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.ctx.Err() != nil {
+		return
+	}
+
+	if _, found := p.entries[key]; found {
+		return
+	}
+
+	if p.inFlight >= p.maxInFlight {
+		return
+	}
+
+	p.inFlight++
+
+	ctx, cancel := context.WithTimeout(p.ctx, p.ttl)
+
+	entry := &prefetchEntry{
+		key:    key,
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	p.entries[key] = p.lru.PushFront(entry)
+
+	go func() {
+		resp := getPage(ctx)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.inFlight--
+
+		entry.resp = resp
+		entry.expiresAt = p.now().Add(p.ttl)
+		close(entry.done)
+
+		elem, found := p.entries[key]
+		if !found || elem.Value != entry { // Evicted while in progress.
+			return
+		}
+
+		// Only buffer successful responses. The request is sent again to the
+		// adapter in case of error.
+		if ctx.Err() != nil || resp.GetSuccess() == nil {
+			p.remove(elem)
+
+			return
+		}
+
+		entry.size = int64(proto.Size(resp))
+		p.bytes += entry.size
+
+		p.evict()
+	}()
+}
+
+// Take removes and returns the response prefetched for the given request, if
+// any. If that prefetch is still in progress, Take waits until it completes or
+// ctx is done.
+// Returns nil if no successful response was prefetched for the request.
+func (p *Prefetcher) Take(ctx context.Context, req *api_adapter_v1.GetPageRequest) *api_adapter_v1.GetPageResponse {
+	key := inflightKey{
+		request:     getRequestKey(req),
+		credentials: getCredentialsKey(req),
+	}
+
+	p.mu.Lock()
+
+	elem, found := p.entries[key]
+	if !found {
+		p.mu.Unlock()
+
+		return nil
+	}
+
+	entry := elem.Value.(*prefetchEntry)
+
+	p.mu.Unlock()
+
+	select {
+	case <-entry.done:
+	case <-ctx.Done():
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if elem, found = p.entries[key]; !found || elem.Value != entry {
+		// Evicted or taken by a concurrent request.
+		return nil
+	}
+
+	p.remove(elem)
+
+	if !p.now().Before(entry.expiresAt) {
+		return nil
+	}
+
+	return entry.resp
+}
+
+// Close cancels all the prefetches in progress and prevents new prefetches
+// from starting.
+func (p *Prefetcher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.cancel()
+
+	for p.lru.Len() > 0 {
+		p.remove(p.lru.Back())
+	}
+}
+
+// evict removes the expired buffered responses and the oldest buffered
+// responses until their total size is below the limit. Prefetches in progress
+// are kept, since removing them would free no space.
+// p.mu must be locked.
+func (p *Prefetcher) evict() {
+	now := p.now()
+
+	for elem := p.lru.Back(); elem != nil; {
+		prev := elem.Prev()
+
+		entry := elem.Value.(*prefetchEntry)
+
+		if entry.resp != nil && (p.bytes > p.maxBytes || !now.Before(entry.expiresAt)) {
+			p.remove(elem)
+		}
+
+		elem = prev
+	}
+}
+
+// remove removes the given element, and cancels its prefetch if it is still
+// in progress.
+// p.mu must be locked.
+func (p *Prefetcher) remove(elem *list.Element) {
+	entry := elem.Value.(*prefetchEntry)
+
+	entry.cancel()
+	p.bytes -= entry.size
+
+	p.lru.Remove(elem)
+	delete(p.entries, entry.key)
+}
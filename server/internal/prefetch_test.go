@@ -0,0 +1,429 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// MockPagingAdapter returns pages containing a single object which name is the
+// cursor, up to 3 pages, and records the requested cursors.
+type MockPagingAdapter struct {
+	mu      sync.Mutex
+	cursors []string
+
+	disablePrefetch bool
+}
+
+func (a *MockPagingAdapter) GetPage(ctx context.Context, request *framework.Request[TestConfigA]) framework.Response {
+	a.mu.Lock()
+	a.cursors = append(a.cursors, request.Cursor)
+	a.mu.Unlock()
+
+	page, _ := strconv.Atoi(request.Cursor)
+
+	var nextCursor string
+	if page < 2 {
+		nextCursor = strconv.Itoa(page + 1)
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    []framework.Object{{"name": request.Cursor}},
+		NextCursor: nextCursor,
+	})
+}
+
+func (a *MockPagingAdapter) Cursors() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]string{}, a.cursors...)
+}
+
+type MockPagingAdapterWithOptOut struct {
+	MockPagingAdapter
+}
+
+func (a *MockPagingAdapterWithOptOut) PrefetchDisabled() bool {
+	return true
+}
+
+type TestConfigWithOptOut struct {
+	NoPrefetch bool `json:"noPrefetch"`
+}
+
+func (c *TestConfigWithOptOut) PrefetchDisabled() bool {
+	return c.NoPrefetch
+}
+
+type MockPagingAdapterWithConfigOptOut struct {
+	MockPagingAdapter
+}
+
+func (a *MockPagingAdapterWithConfigOptOut) GetPage(ctx context.Context, request *framework.Request[TestConfigWithOptOut]) framework.Response {
+	return a.MockPagingAdapter.GetPage(ctx, &framework.Request[TestConfigA]{Cursor: request.Cursor})
+}
+
+func newTestPrefetchRequest(cursor string, config string) *api_adapter_v1.GetPageRequest {
+	return &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:     "1f530a64-0565-49e6-8647-b88e908b7229",
+			Type:   "Mock-1.0.1",
+			Config: []byte(config),
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 100,
+		Cursor:   cursor,
+	}
+}
+
+func TestServer_GetPage_Prefetch(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	tests := map[string]struct {
+		register    func(s *Server) *MockPagingAdapter
+		config      string
+		maxBytes    int64
+		wantCursors []string
+	}{
+		"prefetch": {
+			register: func(s *Server) *MockPagingAdapter {
+				adapter := &MockPagingAdapter{}
+				if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+					t.Fatal(err)
+				}
+
+				return adapter
+			},
+			maxBytes: 1 << 20,
+			// Each page is prefetched right after the previous page is
+			// returned, and the last page has no next cursor.
+			wantCursors: []string{"", "1", "2"},
+		},
+		"buffer_too_small": {
+			register: func(s *Server) *MockPagingAdapter {
+				adapter := &MockPagingAdapter{}
+				if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+					t.Fatal(err)
+				}
+
+				return adapter
+			},
+			maxBytes: 1,
+			// Prefetched pages are evicted, so every page is requested twice,
+			// except the last one which is requested while prefetching.
+			wantCursors: []string{"", "1", "1", "2", "2"},
+		},
+		"adapter_opt_out": {
+			register: func(s *Server) *MockPagingAdapter {
+				adapter := &MockPagingAdapterWithOptOut{}
+				if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+					t.Fatal(err)
+				}
+
+				return &adapter.MockPagingAdapter
+			},
+			maxBytes:    1 << 20,
+			wantCursors: []string{"", "1", "2"},
+		},
+		"config_opt_out": {
+			register: func(s *Server) *MockPagingAdapter {
+				adapter := &MockPagingAdapterWithConfigOptOut{}
+				if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+					t.Fatal(err)
+				}
+
+				return &adapter.MockPagingAdapter
+			},
+			config:      `{"noPrefetch":true}`,
+			maxBytes:    1 << 20,
+			wantCursors: []string{"", "1", "2"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+				"token": validTokens,
+			})
+
+			s := &Server{
+				Tokens:              validTokens,
+				AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+				Prefetcher:          NewPrefetcher(tc.maxBytes, 10, time.Minute),
+			}
+			defer s.Close()
+
+			adapter := tc.register(s)
+
+			cursor := ""
+
+			for i := 0; i < 3; i++ {
+				resp, err := s.GetPage(ctx, newTestPrefetchRequest(cursor, tc.config))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				page := resp.GetSuccess()
+				if page == nil {
+					t.Fatalf("Expected successful response, got %v", resp)
+				}
+
+				AssertDeepEqual(t, cursor, page.Objects[0].Attributes[0].Values[0].GetStringValue())
+
+				// Let the prefetch complete.
+				time.Sleep(20 * time.Millisecond)
+
+				cursor = page.NextCursor
+			}
+
+			AssertDeepEqual(t, "", cursor)
+			AssertDeepEqual(t, tc.wantCursors, adapter.Cursors())
+		})
+	}
+}
+
+func TestPrefetcher_Take(t *testing.T) {
+	p := NewPrefetcher(1<<20, 10, time.Minute)
+	defer p.Close()
+
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	release := make(chan struct{})
+
+	wantResp := api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: "2"})
+
+	p.Start(newTestCacheRequest("1", "Bearer a"), func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		<-release
+
+		return wantResp
+	})
+
+	// Requests with different credentials don't get the prefetched response.
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer b")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+
+	// A request gives up waiting for the prefetch when its context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if resp := p.Take(ctx, newTestCacheRequest("1", "Bearer a")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+
+	close(release)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer a")); resp != wantResp {
+		t.Errorf("Expected %v, got %v", wantResp, resp)
+	}
+
+	// A prefetched response is only returned once.
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer a")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+}
+
+func TestPrefetcher_Close(t *testing.T) {
+	p := NewPrefetcher(1<<20, 10, time.Minute)
+
+	canceled := make(chan struct{})
+
+	p.Start(newTestCacheRequest("1", "Bearer a"), func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		<-ctx.Done()
+		close(canceled)
+
+		return nil
+	})
+
+	p.Close()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the prefetch to be canceled")
+	}
+
+	AssertDeepEqual(t, 0, p.lru.Len())
+}
+
+func TestPrefetcher_MaxInFlight(t *testing.T) {
+	p := NewPrefetcher(1<<20, 2, time.Minute)
+	defer p.Close()
+
+	release := make(chan struct{})
+
+	var calls atomic.Int32
+
+	getPage := func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		calls.Add(1)
+		<-release
+
+		return api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{})
+	}
+
+	for _, cursor := range []string{"1", "2", "3"} {
+		p.Start(newTestCacheRequest(cursor, "Bearer a"), getPage)
+	}
+
+	// The third prefetch is skipped while the first two are in flight.
+	if resp := p.Take(context.Background(), newTestCacheRequest("3", "Bearer a")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+
+	close(release)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer a")); resp == nil {
+		t.Error("Expected a response")
+	}
+
+	// Wait for the second prefetch to complete.
+	p.Take(context.Background(), newTestCacheRequest("2", "Bearer a"))
+
+	p.Start(newTestCacheRequest("3", "Bearer a"), getPage)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("3", "Bearer a")); resp == nil {
+		t.Error("Expected a response")
+	}
+
+	AssertDeepEqual(t, int32(3), calls.Load())
+}
+
+func TestPrefetcher_EvictKeepsInProgress(t *testing.T) {
+	smallResp := api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: "2"})
+	largeResp := api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: strings.Repeat("x", 1024)})
+
+	// Only the small response fits.
+	p := NewPrefetcher(int64(proto.Size(smallResp)), 10, time.Minute)
+	defer p.Close()
+
+	release := make(chan struct{})
+
+	var canceled atomic.Bool
+
+	p.Start(newTestCacheRequest("1", "Bearer a"), func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		<-release
+		canceled.Store(ctx.Err() != nil)
+
+		return smallResp
+	})
+
+	p.Start(newTestCacheRequest("2", "Bearer a"), func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		return largeResp
+	})
+
+	// The large response is evicted once buffered, without canceling the
+	// prefetch still in progress.
+	if resp := p.Take(context.Background(), newTestCacheRequest("2", "Bearer a")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+
+	close(release)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer a")); resp != smallResp {
+		t.Errorf("Expected %v, got %v", smallResp, resp)
+	}
+
+	if canceled.Load() {
+		t.Error("Expected the prefetch in progress not to be canceled")
+	}
+}
+
+func TestPrefetcher_TTLFromCompletion(t *testing.T) {
+	p := NewPrefetcher(1<<20, 10, time.Minute)
+	defer p.Close()
+
+	var mu sync.Mutex
+
+	now := time.Now()
+	p.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return now
+	}
+
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		now = now.Add(d)
+	}
+
+	release := make(chan struct{})
+
+	wantResp := api_adapter_v1.NewGetPageResponseSuccess(&api_adapter_v1.Page{NextCursor: "2"})
+
+	for _, cursor := range []string{"1", "2"} {
+		p.Start(newTestCacheRequest(cursor, "Bearer a"), func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+			<-release
+
+			return wantResp
+		})
+	}
+
+	// The prefetches take longer than the TTL, measured with the fake clock.
+	advance(2 * time.Minute)
+	close(release)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("1", "Bearer a")); resp != wantResp {
+		t.Errorf("Expected %v, got %v", wantResp, resp)
+	}
+
+	// Wait for the other prefetch to complete, then for its response to be
+	// buffered, which is done while p.mu is locked.
+	key := inflightKey{
+		request:     getRequestKey(newTestCacheRequest("2", "Bearer a")),
+		credentials: getCredentialsKey(newTestCacheRequest("2", "Bearer a")),
+	}
+
+	p.mu.Lock()
+	entry := p.entries[key].Value.(*prefetchEntry)
+	p.mu.Unlock()
+
+	<-entry.done
+
+	p.mu.Lock()
+	p.mu.Unlock()
+
+	// The other response expires once buffered for longer than the TTL.
+	advance(time.Minute)
+
+	if resp := p.Take(context.Background(), newTestCacheRequest("2", "Bearer a")); resp != nil {
+		t.Errorf("Expected no response, got %v", resp)
+	}
+}
@@ -15,10 +15,15 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+	"github.com/sgnl-ai/adapter-framework/pkg/connector"
+	"github.com/sgnl-ai/adapter-framework/pkg/secrets"
 )
 
 // entityReverseIdMapping maps external IDs to IDs.
@@ -35,9 +40,28 @@ type entityReverseIdMapping struct {
 	ChildEntities map[string]*entityReverseIdMapping
 }
 
+// requestOptions configures how a GetPageRequest is converted into an adapter
+// Request.
+type requestOptions struct {
+	// secretResolver resolves the secret references in the datasource config.
+	// If nil, secret references are not resolved.
+	secretResolver secrets.Resolver
+
+	// strictConfig indicates whether configs containing unknown fields are
+	// rejected.
+	strictConfig bool
+
+	// migrations are applied to the datasource config before resolving its
+	// secret references and parsing it.
+	migrations config.Migrations
+}
+
 // getAdapterRequest converts a GetPageRequest into an adapter Request.
+// opts may be nil.
 func getAdapterRequest[Config any](
+	ctx context.Context,
 	req *api_adapter_v1.GetPageRequest,
+	opts *requestOptions,
 ) (adapterRequest *framework.Request[Config], reverseMapping *entityReverseIdMapping, adapterErr *api_adapter_v1.Error) {
 	var errMsg string
 
@@ -78,18 +102,10 @@ func getAdapterRequest[Config any](
 	adapterRequest = &framework.Request[Config]{}
 
 	if len(req.Datasource.Config) > 0 {
-		config, err := ParseConfig[Config](req.Datasource.Config)
-
-		if err != nil {
-			adapterErr = &api_adapter_v1.Error{
-				Message: fmt.Sprintf("Config in datasource config could not parsed as JSON: %s.", err),
-				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
-			}
-
+		adapterRequest.Config, adapterErr = getAdapterConfig[Config](ctx, req.Datasource.Config, opts)
+		if adapterErr != nil {
 			return nil, nil, adapterErr
 		}
-
-		adapterRequest.Config = config
 	}
 
 	var entityConfig *framework.EntityConfig
@@ -101,6 +117,10 @@ func getAdapterRequest[Config any](
 	}
 
 	adapterRequest.DatasourceID = req.Datasource.Id
+	adapterRequest.DatasourceType = req.Datasource.Type
+	adapterRequest.TenantID = req.TenantId
+	adapterRequest.ClientID = req.ClientId
+	adapterRequest.ConnectorInfo = getConnectorInfo(req.Datasource)
 	adapterRequest.Address = req.Datasource.Address
 	adapterRequest.Auth = getAdapterAuth(req.Datasource.Auth)
 	adapterRequest.Entity = *entityConfig
@@ -108,9 +128,158 @@ func getAdapterRequest[Config any](
 	adapterRequest.PageSize = req.PageSize
 	adapterRequest.Cursor = req.Cursor
 
+	if req.ChildPage != nil {
+		adapterRequest.ChildPage, adapterErr = getAdapterChildPage(req.Entity, req.ChildPage)
+		if adapterErr != nil {
+			return nil, nil, adapterErr
+		}
+	}
+
 	return
 }
 
+// getAdapterChildPage converts a ChildPageRequest for the given entity into an
+// adapter ChildPageRequest.
+func getAdapterChildPage(
+	entity *api_adapter_v1.EntityConfig,
+	childPage *api_adapter_v1.ChildPageRequest,
+) (*framework.ChildPageRequest, *api_adapter_v1.Error) {
+	if childPage.ParentId == "" {
+		return nil, &api_adapter_v1.Error{
+			Message: "Child page request contains no parent ID.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+		}
+	}
+
+	for _, childEntity := range entity.ChildEntities {
+		if childEntity.Id == childPage.ChildEntityId {
+			return &framework.ChildPageRequest{
+				ChildEntityExternalID: childEntity.ExternalId,
+				ParentID:              childPage.ParentId,
+				Cursor:                childPage.Cursor,
+			}, nil
+		}
+	}
+
+	return nil, &api_adapter_v1.Error{
+		Message: fmt.Sprintf("Child page request contains an ID which is not a child entity of entity %s: %s.", entity.Id, childPage.ChildEntityId),
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+	}
+}
+
+// getAdapterConfig migrates the given datasource config to the latest version,
+// resolves its secret references and parses it into a Config.
+// opts may be nil.
+func getAdapterConfig[Config any](
+	ctx context.Context,
+	data []byte,
+	opts *requestOptions,
+) (adapterConfig *Config, adapterErr *api_adapter_v1.Error) {
+	if opts == nil {
+		opts = &requestOptions{}
+	}
+
+	data, _, adapterErr = migrateConfig(data, opts.migrations)
+	if adapterErr != nil {
+		return nil, adapterErr
+	}
+
+	if opts.secretResolver != nil {
+		var err error
+
+		// Never include the resolved config in error messages, since it
+		// contains secrets.
+		data, err = secrets.ResolveJSON(ctx, opts.secretResolver, data)
+		if err != nil {
+			adapterErr = &api_adapter_v1.Error{
+				Message: fmt.Sprintf("Config in datasource config contains secret references that could not be resolved: %s.", err),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}
+
+			return nil, adapterErr
+		}
+	}
+
+	var parseOpts []config.Option
+	if opts.strictConfig {
+		parseOpts = append(parseOpts, config.Strict())
+	}
+
+	adapterConfig, err := ParseConfig[Config](data, parseOpts...)
+	if err != nil {
+		errMsg := fmt.Sprintf("Config in datasource config could not parsed as JSON: %s.", err)
+
+		if validationErrs := (config.ValidationErrors)(nil); errors.As(err, &validationErrs) {
+			errMsg = fmt.Sprintf("Config in datasource config is invalid: %s.", validationErrs)
+		}
+
+		adapterErr = &api_adapter_v1.Error{
+			Message: errMsg,
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+
+		return nil, adapterErr
+	}
+
+	return adapterConfig, nil
+}
+
+// migrateConfig migrates the given datasource config to the latest version
+// using the given migrations, and returns the migrated config and the version
+// of the given config.
+func migrateConfig(
+	data []byte,
+	migrations config.Migrations,
+) (migratedData []byte, version int64, adapterErr *api_adapter_v1.Error) {
+	if len(migrations) == 0 {
+		return data, 0, nil
+	}
+
+	migratedData, version, err := migrations.Migrate(data)
+	if err != nil {
+		adapterErr = &api_adapter_v1.Error{
+			Message: fmt.Sprintf("Config in datasource config could not be migrated to version %d: %s.", migrations.Version(), err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+
+		return nil, version, adapterErr
+	}
+
+	return migratedData, version, nil
+}
+
+// getConnectorInfo converts the ConnectorInfo of a request DatasourceConfig into a
+// connector.ConnectorInfo.
+// If the source of the connector is not set, it is the given datasource.
+// Returns nil if the datasource is not accessed via a connector.
+func getConnectorInfo(datasource *api_adapter_v1.DatasourceConfig) *connector.ConnectorInfo {
+	ci := datasource.GetConnectorInfo()
+	if ci == nil {
+		return nil
+	}
+
+	info := &connector.ConnectorInfo{
+		ID:       ci.Id,
+		TenantID: ci.TenantId,
+		ClientID: ci.ClientId,
+		SourceID: ci.SourceId,
+	}
+
+	switch ci.SourceType {
+	case api_adapter_v1.ConnectorSourceType_CONNECTOR_SOURCE_TYPE_DATASOURCE:
+		info.SourceType = connector.Datasource
+	case api_adapter_v1.ConnectorSourceType_CONNECTOR_SOURCE_TYPE_INTEGRATION:
+		info.SourceType = connector.Integration
+	case api_adapter_v1.ConnectorSourceType_CONNECTOR_SOURCE_TYPE_UNSPECIFIED:
+		if ci.SourceId == "" {
+			info.SourceType = connector.Datasource
+			info.SourceID = datasource.Id
+		}
+	}
+
+	return info
+}
+
 // getAdapterAuth converts a request DatasourceAuthCredentials into an adapter
 // DatasourceAuthCredentials.
 func getAdapterAuth(
@@ -15,10 +15,13 @@
 package internal
 
 import (
+	"context"
 	"testing"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/connector"
+	"github.com/sgnl-ai/adapter-framework/pkg/secrets"
 )
 
 func TestGetAdapterRequest(t *testing.T) {
@@ -206,6 +209,14 @@ func TestGetAdapterRequest(t *testing.T) {
 							HttpAuthorization: "Bearer mysecret",
 						},
 					},
+					Type: "Test-1.0.0",
+					ConnectorInfo: &api_adapter_v1.ConnectorInfo{
+						Id:         "connector-id",
+						TenantId:   "tenant-id",
+						ClientId:   "client-id",
+						SourceType: api_adapter_v1.ConnectorSourceType_CONNECTOR_SOURCE_TYPE_INTEGRATION,
+						SourceId:   "integration-id",
+					},
 				},
 				Entity: &api_adapter_v1.EntityConfig{
 					Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
@@ -223,9 +234,21 @@ func TestGetAdapterRequest(t *testing.T) {
 				},
 				PageSize: 100,
 				Cursor:   "the cursor",
+				TenantId: "tenant-id",
+				ClientId: "client-id",
 			},
 			wantAdapterRequest: &framework.Request[TestConfigA]{
-				DatasourceID: "1f530a64-0565-49e6-8647-b88e908b7229",
+				DatasourceID:   "1f530a64-0565-49e6-8647-b88e908b7229",
+				DatasourceType: "Test-1.0.0",
+				TenantID:       "tenant-id",
+				ClientID:       "client-id",
+				ConnectorInfo: &connector.ConnectorInfo{
+					ID:         "connector-id",
+					TenantID:   "tenant-id",
+					ClientID:   "client-id",
+					SourceType: connector.Integration,
+					SourceID:   "integration-id",
+				},
 				Config: &TestConfigA{
 					A: "a value",
 					B: "b value",
@@ -306,11 +329,187 @@ func TestGetAdapterRequest(t *testing.T) {
 				},
 			},
 		},
+		"valid_child_page": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{
+					Id: "1f530a64-0565-49e6-8647-b88e908b7229",
+				},
+				Entity: &api_adapter_v1.EntityConfig{
+					Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+					ExternalId: "groups",
+					Attributes: []*api_adapter_v1.AttributeConfig{
+						{
+							Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+							ExternalId: "id",
+							Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							UniqueId:   true,
+						},
+					},
+					ChildEntities: []*api_adapter_v1.EntityConfig{
+						{
+							Id:         "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+							ExternalId: "members",
+							Attributes: []*api_adapter_v1.AttributeConfig{
+								{
+									Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+									ExternalId: "id",
+									Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+								},
+							},
+						},
+					},
+				},
+				PageSize: 100,
+				Cursor:   "ignored",
+				ChildPage: &api_adapter_v1.ChildPageRequest{
+					ChildEntityId: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+					ParentId:      "group-1",
+					Cursor:        "100",
+				},
+			},
+			wantAdapterRequest: &framework.Request[TestConfigA]{
+				DatasourceID: "1f530a64-0565-49e6-8647-b88e908b7229",
+				Entity: framework.EntityConfig{
+					Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+					ExternalId: "groups",
+					Attributes: []*framework.AttributeConfig{
+						{
+							ExternalId: "id",
+							Type:       framework.AttributeTypeString,
+							UniqueId:   true,
+						},
+					},
+					ChildEntities: []*framework.EntityConfig{
+						{
+							Id:         "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+							ExternalId: "members",
+							Attributes: []*framework.AttributeConfig{
+								{
+									ExternalId: "id",
+									Type:       framework.AttributeTypeString,
+								},
+							},
+						},
+					},
+				},
+				PageSize: 100,
+				Cursor:   "ignored",
+				ChildPage: &framework.ChildPageRequest{
+					ChildEntityExternalID: "members",
+					ParentID:              "group-1",
+					Cursor:                "100",
+				},
+			},
+			wantReverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"id": {
+						Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+						ExternalId: "id",
+						Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+						UniqueId:   true,
+					},
+				},
+				ChildEntities: map[string]*entityReverseIdMapping{
+					"members": {
+						Id: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						Attributes: map[string]*api_adapter_v1.AttributeConfig{
+							"id": {
+								Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+								ExternalId: "id",
+								Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							},
+						},
+					},
+				},
+			},
+		},
+		"invalid_child_page_entity": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{
+					Id: "1f530a64-0565-49e6-8647-b88e908b7229",
+				},
+				Entity: &api_adapter_v1.EntityConfig{
+					Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+					ExternalId: "groups",
+					Attributes: []*api_adapter_v1.AttributeConfig{
+						{
+							Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+							ExternalId: "id",
+							Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							UniqueId:   true,
+						},
+					},
+					ChildEntities: []*api_adapter_v1.EntityConfig{
+						{
+							Id:         "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+							ExternalId: "members",
+							Attributes: []*api_adapter_v1.AttributeConfig{
+								{
+									Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+									ExternalId: "id",
+									Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+								},
+							},
+						},
+					},
+				},
+				PageSize: 100,
+				ChildPage: &api_adapter_v1.ChildPageRequest{
+					ChildEntityId: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+					ParentId:      "group-1",
+				},
+			},
+			wantAdapterErr: &api_adapter_v1.Error{
+				Message: "Child page request contains an ID which is not a child entity of entity 00d58abb-0b80-4745-927a-af9b2fb612dd: 00d58abb-0b80-4745-927a-af9b2fb612dd.",
+				Code:    1, // INVALID_PAGE_REQUEST_CONFIG
+			},
+		},
+		"invalid_child_page_no_parent_id": {
+			req: &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{
+					Id: "1f530a64-0565-49e6-8647-b88e908b7229",
+				},
+				Entity: &api_adapter_v1.EntityConfig{
+					Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+					ExternalId: "groups",
+					Attributes: []*api_adapter_v1.AttributeConfig{
+						{
+							Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+							ExternalId: "id",
+							Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							UniqueId:   true,
+						},
+					},
+					ChildEntities: []*api_adapter_v1.EntityConfig{
+						{
+							Id:         "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+							ExternalId: "members",
+							Attributes: []*api_adapter_v1.AttributeConfig{
+								{
+									Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+									ExternalId: "id",
+									Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+								},
+							},
+						},
+					},
+				},
+				PageSize: 100,
+				ChildPage: &api_adapter_v1.ChildPageRequest{
+					ChildEntityId: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+				},
+			},
+			wantAdapterErr: &api_adapter_v1.Error{
+				Message: "Child page request contains no parent ID.",
+				Code:    1, // INVALID_PAGE_REQUEST_CONFIG
+			},
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotAdapterRequest, gotReverseMapping, gotAdapterErr := getAdapterRequest[TestConfigA](tc.req)
+			gotAdapterRequest, gotReverseMapping, gotAdapterErr := getAdapterRequest[TestConfigA](context.Background(), tc.req, nil)
 			AssertDeepEqual(t, tc.wantAdapterRequest, gotAdapterRequest)
 			AssertDeepEqual(t, tc.wantReverseMapping, gotReverseMapping)
 			AssertDeepEqual(t, tc.wantAdapterErr, gotAdapterErr)
@@ -318,6 +517,144 @@ func TestGetAdapterRequest(t *testing.T) {
 	}
 }
 
+func TestGetAdapterRequest_SecretReferences(t *testing.T) {
+	t.Setenv("TEST_SECRET_B", "b secret")
+
+	opts := &requestOptions{
+		secretResolver: secrets.SchemeResolver{"env": secrets.EnvResolver{Prefix: "TEST_"}},
+	}
+
+	req := &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:     "1f530a64-0565-49e6-8647-b88e908b7229",
+			Config: []byte(`{"a":"a value","b":{"$secret":"env:TEST_SECRET_B"}}`),
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 100,
+	}
+
+	gotAdapterRequest, _, gotAdapterErr := getAdapterRequest[TestConfigA](context.Background(), req, opts)
+	AssertDeepEqual(t, (*api_adapter_v1.Error)(nil), gotAdapterErr)
+	AssertDeepEqual(t, &TestConfigA{A: "a value", B: "b secret"}, gotAdapterRequest.Config)
+
+	req.Datasource.Config = []byte(`{"a":"a value","b":{"$secret":"env:TEST_SECRET_MISSING"}}`)
+
+	_, _, gotAdapterErr = getAdapterRequest[TestConfigA](context.Background(), req, opts)
+	AssertDeepEqual(t, &api_adapter_v1.Error{
+		Message: "Config in datasource config contains secret references that could not be resolved: failed to resolve secret reference at $.b: secret environment variable TEST_SECRET_MISSING is not set.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+	}, gotAdapterErr)
+}
+
+func TestGetAdapterRequest_InvalidConfig(t *testing.T) {
+	type ValidatedConfig struct {
+		URL string `json:"url" validate:"required,url"`
+	}
+
+	req := &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:     "1f530a64-0565-49e6-8647-b88e908b7229",
+			Config: []byte(`{"url":"https://example.com","urll":"https://example.com"}`),
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "00d58abb-0b80-4745-927a-af9b2fb612dd",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 100,
+	}
+
+	gotAdapterRequest, _, gotAdapterErr := getAdapterRequest[ValidatedConfig](context.Background(), req, nil)
+	AssertDeepEqual(t, (*api_adapter_v1.Error)(nil), gotAdapterErr)
+	AssertDeepEqual(t, &ValidatedConfig{URL: "https://example.com"}, gotAdapterRequest.Config)
+
+	_, _, gotAdapterErr = getAdapterRequest[ValidatedConfig](context.Background(), req, &requestOptions{strictConfig: true})
+	AssertDeepEqual(t, &api_adapter_v1.Error{
+		Message: "Config in datasource config is invalid: $.urll: unknown field.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+	}, gotAdapterErr)
+
+	req.Datasource.Config = []byte(`{"url":"example.com"}`)
+
+	_, _, gotAdapterErr = getAdapterRequest[ValidatedConfig](context.Background(), req, nil)
+	AssertDeepEqual(t, &api_adapter_v1.Error{
+		Message: "Config in datasource config is invalid: $.url: must be an absolute URL.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+	}, gotAdapterErr)
+}
+
+func TestGetConnectorInfo(t *testing.T) {
+	tests := map[string]struct {
+		datasource *api_adapter_v1.DatasourceConfig
+		want       *connector.ConnectorInfo
+	}{
+		"no_connector": {
+			datasource: &api_adapter_v1.DatasourceConfig{Id: "datasource-id"},
+		},
+		"default_source": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Id:            "datasource-id",
+				ConnectorInfo: &api_adapter_v1.ConnectorInfo{Id: "connector-id"},
+			},
+			want: &connector.ConnectorInfo{
+				ID:         "connector-id",
+				SourceType: connector.Datasource,
+				SourceID:   "datasource-id",
+			},
+		},
+		"datasource_source": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Id: "datasource-id",
+				ConnectorInfo: &api_adapter_v1.ConnectorInfo{
+					Id:         "connector-id",
+					SourceType: api_adapter_v1.ConnectorSourceType_CONNECTOR_SOURCE_TYPE_DATASOURCE,
+					SourceId:   "other-datasource-id",
+				},
+			},
+			want: &connector.ConnectorInfo{
+				ID:         "connector-id",
+				SourceType: connector.Datasource,
+				SourceID:   "other-datasource-id",
+			},
+		},
+		"unspecified_source_type_with_source_id": {
+			datasource: &api_adapter_v1.DatasourceConfig{
+				Id: "datasource-id",
+				ConnectorInfo: &api_adapter_v1.ConnectorInfo{
+					Id:       "connector-id",
+					SourceId: "source-id",
+				},
+			},
+			want: &connector.ConnectorInfo{
+				ID:       "connector-id",
+				SourceID: "source-id",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			AssertDeepEqual(t, tc.want, getConnectorInfo(tc.datasource))
+		})
+	}
+}
+
 func TestGetAdapterAuth(t *testing.T) {
 	tests := map[string]struct {
 		auth     *api_adapter_v1.DatasourceAuthCredentials
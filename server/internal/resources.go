@@ -0,0 +1,344 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// DefaultResourceIdleTimeout is the duration after which unused datasource
+// resources are closed, if not configured otherwise.
+const DefaultResourceIdleTimeout = 10 * time.Minute
+
+// resourceKey identifies a datasource resource.
+type resourceKey [sha256.Size]byte
+
+// getResourceKey returns the hash of the datasource type, ID, address, config,
+// credentials and connector info of the given request.
+func getResourceKey(req *api_adapter_v1.GetPageRequest) (key resourceKey) {
+	h := sha256.New()
+
+	writeHashField(h, []byte(req.GetDatasource().GetType()))
+	writeHashField(h, []byte(req.GetDatasource().GetId()))
+	writeHashField(h, []byte(req.GetDatasource().GetAddress()))
+	writeHashField(h, req.GetDatasource().GetConfig())
+	writeHashField(h, marshalDeterministic(req.GetDatasource().GetAuth()))
+	writeHashField(h, marshalDeterministic(req.GetDatasource().GetConnectorInfo()))
+
+	h.Sum(key[:0])
+
+	return
+}
+
+// datasourceKey identifies a datasource across adapters.
+type datasourceKey struct {
+	datasourceType string
+	datasourceID   string
+}
+
+// ResourcePool contains the resources created by adapters implementing
+// framework.Lifecycle, for reuse across GetPage requests for the same
+// datasource.
+//
+// Each datasource has at most one current resource. When a datasource's
+// config, address or credentials change, a new resource is created and the
+// previous one is closed once no longer used. Resources unused for longer than
+// the idle timeout are closed.
+type ResourcePool struct {
+	idleTimeout time.Duration
+
+	// stop is closed by Close to stop the eviction of idle resources.
+	stop chan struct{}
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// entries maps each resource key to its resource.
+	entries map[resourceKey]*resourceEntry
+
+	// current maps each datasource to the key of its current resource.
+	current map[datasourceKey]resourceKey
+
+	// closed is set by Close.
+	closed bool
+
+	// closers close the resources that became unused while mu was locked.
+	closers []func()
+
+	// now returns the current time. Overridden in tests.
+	now func() time.Time
+}
+
+// resourceEntry is a resource being initialized or initialized.
+type resourceEntry struct {
+	key        resourceKey
+	datasource datasourceKey
+
+	// ready is closed once the resource is initialized.
+	ready chan struct{}
+
+	// resource and err are set once ready is closed.
+	resource any
+	err      *framework.Error
+
+	// close closes the resource.
+	close func(resource any)
+
+	// refs is the number of requests using the resource.
+	refs int
+
+	// lastUsed is the last time the resource was released.
+	lastUsed time.Time
+
+	// removed is set once the entry is removed from the pool, after which the
+	// resource is closed when refs drops to zero.
+	removed bool
+}
+
+// NewResourcePool returns a ResourcePool that closes resources after they have
+// been unused for the given idle timeout.
+func NewResourcePool(idleTimeout time.Duration) *ResourcePool {
+	p := &ResourcePool{
+		idleTimeout: idleTimeout,
+		stop:        make(chan struct{}),
+		entries:     make(map[resourceKey]*resourceEntry),
+		current:     make(map[datasourceKey]resourceKey),
+		now:         time.Now,
+	}
+
+	go p.evictIdle()
+
+	return p
+}
+
+// Acquire returns the resource for the datasource of the given request,
+// calling init to create it if needed, and a function which must be called
+// once the resource is no longer used by the request.
+// If the resource is being initialized by a concurrent request, Acquire waits
+// for it, unless ctx is done.
+// The previous resource of the datasource, if any, is closed once unused.
+func (p *ResourcePool) Acquire(
+	ctx context.Context,
+	req *api_adapter_v1.GetPageRequest,
+	init func() (any, *framework.Error),
+	closeResource func(resource any),
+) (resource any, release func(), err *framework.Error) {
+	key := getResourceKey(req)
+	ds := datasourceKey{
+		datasourceType: req.GetDatasource().GetType(),
+		datasourceID:   req.GetDatasource().GetId(),
+	}
+
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+
+		return nil, nil, &framework.Error{
+			Message: "Server is shutting down.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	entry, found := p.entries[key]
+	if !found {
+		entry = &resourceEntry{
+			key:        key,
+			datasource: ds,
+			ready:      make(chan struct{}),
+			close:      closeResource,
+		}
+
+		p.entries[key] = entry
+
+		// Replace the previous resource of the datasource, e.g. after its
+		// credentials were rotated.
+		if previousKey, found := p.current[ds]; found {
+			p.remove(p.entries[previousKey])
+		}
+
+		p.current[ds] = key
+	}
+
+	entry.refs++
+
+	p.unlockAndClose()
+
+	if !found {
+		initResource(entry, init)
+
+		if entry.err != nil {
+			p.mu.Lock()
+			p.remove(entry)
+			p.unlockAndClose()
+		}
+	} else {
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			p.release(entry)
+
+			return nil, nil, &framework.Error{
+				Message: "Request canceled while waiting for the datasource resource to be initialized.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+			}
+		}
+	}
+
+	if entry.err != nil {
+		p.release(entry)
+
+		return nil, nil, entry.err
+	}
+
+	var once sync.Once
+
+	return entry.resource, func() { once.Do(func() { p.release(entry) }) }, nil
+}
+
+// initResource initializes the resource of the given entry with init, and
+// marks the entry as ready even if init panics, so that the concurrent requests
+// waiting for the resource don't block. A panic is returned as the error of the
+// entry.
+func initResource(entry *resourceEntry, init func() (any, *framework.Error)) {
+	defer close(entry.ready)
+
+	defer func() {
+		if r := recover(); r != nil {
+			entry.resource = nil
+			entry.err = &framework.Error{
+				Message: "Adapter failed to initialize the datasource resource.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+	}()
+
+	entry.resource, entry.err = init()
+}
+
+// Close closes all the unused resources, and the resources in use once they
+// are released, and prevents new resources from being created.
+func (p *ResourcePool) Close() {
+	p.mu.Lock()
+	defer p.unlockAndClose()
+
+	if p.closed {
+		return
+	}
+
+	p.closed = true
+	close(p.stop)
+
+	for _, entry := range p.entries {
+		p.remove(entry)
+	}
+}
+
+// release decrements the number of requests using the given resource, and
+// closes it if it was removed and is no longer used.
+func (p *ResourcePool) release(entry *resourceEntry) {
+	p.mu.Lock()
+	defer p.unlockAndClose()
+
+	entry.refs--
+	entry.lastUsed = p.now()
+
+	p.closeIfUnused(entry)
+}
+
+// remove removes the given entry from the pool, and closes its resource if it
+// is no longer used.
+// p.mu must be locked.
+func (p *ResourcePool) remove(entry *resourceEntry) {
+	if entry == nil || entry.removed {
+		return
+	}
+
+	entry.removed = true
+
+	delete(p.entries, entry.key)
+
+	if p.current[entry.datasource] == entry.key {
+		delete(p.current, entry.datasource)
+	}
+
+	p.closeIfUnused(entry)
+}
+
+// closeIfUnused schedules the closing of the resource of the given entry if
+// the entry was removed and the resource is no longer used.
+// p.mu must be locked.
+func (p *ResourcePool) closeIfUnused(entry *resourceEntry) {
+	if !entry.removed || entry.refs > 0 {
+		return
+	}
+
+	// Only close successfully initialized resources, once.
+	if entry.err == nil && entry.close != nil {
+		resource, closeResource := entry.resource, entry.close
+		entry.close = nil
+
+		p.closers = append(p.closers, func() { closeResource(resource) })
+	}
+}
+
+// unlockAndClose unlocks p.mu, then closes the resources that became unused
+// while it was locked, since closing may block, e.g. on network I/O.
+func (p *ResourcePool) unlockAndClose() {
+	closers := p.closers
+	p.closers = nil
+
+	p.mu.Unlock()
+
+	for _, closeResource := range closers {
+		closeResource()
+	}
+}
+
+// evictIdle periodically removes the resources unused for longer than the idle
+// timeout, until the pool is closed.
+func (p *ResourcePool) evictIdle() {
+	ticker := time.NewTicker(max(p.idleTimeout/2, time.Second))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.evict()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// evict removes the resources unused for longer than the idle timeout.
+func (p *ResourcePool) evict() {
+	p.mu.Lock()
+	defer p.unlockAndClose()
+
+	now := p.now()
+
+	for _, entry := range p.entries {
+		if entry.refs == 0 && now.Sub(entry.lastUsed) >= p.idleTimeout {
+			p.remove(entry)
+		}
+	}
+}
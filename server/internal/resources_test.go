@@ -0,0 +1,360 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	grpc_metadata "google.golang.org/grpc/metadata"
+)
+
+// MockClient is a resource created by MockLifecycleAdapter.
+type MockClient struct {
+	Authorization string
+}
+
+// MockLifecycleAdapter creates a MockClient per datasource, and returns pages
+// containing the authorization of the client it got in the context.
+type MockLifecycleAdapter struct {
+	mu     sync.Mutex
+	inits  int
+	closed []*MockClient
+
+	initErr *framework.Error
+}
+
+func (a *MockLifecycleAdapter) Init(ctx context.Context, request *framework.Request[TestConfigA]) (any, *framework.Error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inits++
+
+	if a.initErr != nil {
+		return nil, a.initErr
+	}
+
+	return &MockClient{Authorization: request.Auth.HTTPAuthorization}, nil
+}
+
+func (a *MockLifecycleAdapter) Close(resource any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.closed = append(a.closed, resource.(*MockClient))
+}
+
+func (a *MockLifecycleAdapter) GetPage(ctx context.Context, request *framework.Request[TestConfigA]) framework.Response {
+	client, ok := framework.DatasourceResource[*MockClient](ctx)
+	if !ok {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: "No client in context.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects: []framework.Object{{"name": client.Authorization}},
+	})
+}
+
+func (a *MockLifecycleAdapter) Stats() (inits int, closed []*MockClient) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.inits, append([]*MockClient{}, a.closed...)
+}
+
+func newTestResourceRequest(datasourceID, authorization string) *api_adapter_v1.GetPageRequest {
+	return &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:   datasourceID,
+			Type: "Mock-1.0.0",
+			Auth: &api_adapter_v1.DatasourceAuthCredentials{
+				AuthMechanism: &api_adapter_v1.DatasourceAuthCredentials_HttpAuthorization{
+					HttpAuthorization: authorization,
+				},
+			},
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "entity-abc",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "attr-123",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 10,
+	}
+}
+
+func TestServer_GetPage_Lifecycle(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	adapter := &MockLifecycleAdapter{}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+	}
+
+	if err := RegisterAdapter(s, "Mock-1.0.0", adapter); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.ResourcePool == nil {
+		t.Fatal("Expected a resource pool to be created")
+	}
+
+	getName := func(req *api_adapter_v1.GetPageRequest) string {
+		resp, err := s.GetPage(ctx, req)
+		if err != nil {
+			t.Fatalf("GetPage returned error: %v", err)
+		}
+
+		if resp.GetSuccess() == nil {
+			t.Fatalf("Expected successful response, got %v", resp)
+		}
+
+		return resp.GetSuccess().Objects[0].Attributes[0].Values[0].GetStringValue()
+	}
+
+	// Concurrent requests for the same datasource share a single resource.
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if got := getName(newTestResourceRequest("datasource-1", "Bearer v1")); got != "Bearer v1" {
+				t.Errorf("Expected Bearer v1, got %s", got)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if inits, closed := adapter.Stats(); inits != 1 || len(closed) != 0 {
+		t.Fatalf("Expected 1 init and 0 close, got %d and %d", inits, len(closed))
+	}
+
+	// Another datasource gets its own resource.
+	getName(newTestResourceRequest("datasource-2", "Bearer v1"))
+
+	// Rotating the credentials of a datasource replaces its resource.
+	if got := getName(newTestResourceRequest("datasource-1", "Bearer v2")); got != "Bearer v2" {
+		t.Errorf("Expected Bearer v2, got %s", got)
+	}
+
+	inits, closed := adapter.Stats()
+	if inits != 3 || len(closed) != 1 || closed[0].Authorization != "Bearer v1" {
+		t.Fatalf("Expected 3 inits and the Bearer v1 resource closed, got %d and %v", inits, closed)
+	}
+
+	s.Close()
+
+	if _, closed := adapter.Stats(); len(closed) != 3 {
+		t.Fatalf("Expected all 3 resources closed, got %d", len(closed))
+	}
+
+	resp, _ := s.GetPage(ctx, newTestResourceRequest("datasource-1", "Bearer v2"))
+	if resp.GetError().GetCode() != api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL {
+		t.Errorf("Expected an internal error after Close, got %v", resp)
+	}
+}
+
+func TestServer_GetPage_LifecycleInitError(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	adapter := &MockLifecycleAdapter{
+		initErr: &framework.Error{
+			Message: "Failed to authenticate.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+		},
+	}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+	}
+	defer s.Close()
+
+	if err := RegisterAdapter(s, "Mock-1.0.0", adapter); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 2 {
+		resp, err := s.GetPage(ctx, newTestResourceRequest("datasource-1", "Bearer v1"))
+		if err != nil {
+			t.Fatalf("GetPage returned error: %v", err)
+		}
+
+		AssertDeepEqual(t, api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+			Message: "Failed to authenticate.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+		}), resp)
+
+		// Errors are not cached.
+		if inits, closed := adapter.Stats(); inits != i+1 || len(closed) != 0 {
+			t.Fatalf("Expected %d inits and 0 close, got %d and %d", i+1, inits, len(closed))
+		}
+	}
+}
+
+func TestResourcePool_EvictIdle(t *testing.T) {
+	pool := NewResourcePool(time.Minute)
+	defer pool.Close()
+
+	now := time.Now()
+	pool.now = func() time.Time { return now }
+
+	var closed []string
+
+	acquire := func(datasourceID string) func() {
+		_, release, err := pool.Acquire(context.Background(), newTestResourceRequest(datasourceID, "Bearer v1"),
+			func() (any, *framework.Error) { return datasourceID, nil },
+			func(resource any) { closed = append(closed, fmt.Sprint(resource)) },
+		)
+		if err != nil {
+			t.Fatalf("Acquire returned error: %v", err)
+		}
+
+		return release
+	}
+
+	acquire("idle")()
+	releaseInUse := acquire("in-use")
+
+	now = now.Add(2 * time.Minute)
+	pool.evict()
+
+	AssertDeepEqual(t, []string{"idle"}, closed)
+
+	releaseInUse()
+	releaseInUse() // Releasing twice is a no-op.
+
+	now = now.Add(30 * time.Second)
+	pool.evict()
+
+	AssertDeepEqual(t, []string{"idle"}, closed)
+
+	now = now.Add(30 * time.Second)
+	pool.evict()
+
+	AssertDeepEqual(t, []string{"idle", "in-use"}, closed)
+}
+
+func TestResourcePool_AcquireInitPanic(t *testing.T) {
+	pool := NewResourcePool(time.Minute)
+	defer pool.Close()
+
+	req := newTestResourceRequest("datasource-1", "Bearer v1")
+	key := getResourceKey(req)
+
+	wantErr := &framework.Error{
+		Message: "Adapter failed to initialize the datasource resource.",
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	}
+
+	release := make(chan struct{})
+	errs := make(chan *framework.Error, 1)
+
+	go func() {
+		_, _, err := pool.Acquire(context.Background(), req,
+			func() (any, *framework.Error) {
+				<-release
+				panic("init failed")
+			},
+			func(resource any) {},
+		)
+		errs <- err
+	}()
+
+	// Release the panicking init once a second request waits for it.
+	go func() {
+		for {
+			pool.mu.Lock()
+			entry, found := pool.entries[key]
+			waiting := found && entry.refs == 2
+			pool.mu.Unlock()
+
+			if waiting {
+				close(release)
+
+				return
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	// Wait until the first request is initializing the resource.
+	for {
+		pool.mu.Lock()
+		_, found := pool.entries[key]
+		pool.mu.Unlock()
+
+		if found {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, _, err := pool.Acquire(ctx, req,
+		func() (any, *framework.Error) {
+			t.Error("Expected the resource to be initialized by the first request")
+
+			return nil, nil
+		},
+		func(resource any) {},
+	)
+
+	AssertDeepEqual(t, wantErr, err)
+	AssertDeepEqual(t, wantErr, <-errs)
+
+	// The failed resource is removed, so that it is initialized again.
+	resource, releaseResource, err := pool.Acquire(context.Background(), req,
+		func() (any, *framework.Error) { return "resource", nil },
+		func(resource any) {},
+	)
+	if err != nil {
+		t.Fatalf("Acquire returned error: %v", err)
+	}
+	defer releaseResource()
+
+	AssertDeepEqual(t, any("resource"), resource)
+}
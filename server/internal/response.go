@@ -20,6 +20,7 @@ import (
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -37,8 +38,15 @@ func getResponse(
 
 	if resp.Error != nil {
 		err := &api_adapter_v1.Error{
-			Message: resp.Error.Message,
-			Code:    resp.Error.Code,
+			Message:             resp.Error.Message,
+			Code:                resp.Error.Code,
+			UpstreamStatusCode:  int32(resp.Error.UpstreamStatusCode),
+			UpstreamRequestId:   resp.Error.UpstreamRequestID,
+			AttributeExternalId: resp.Error.AttributeExternalID,
+			EntityExternalId:    resp.Error.EntityExternalID,
+			Reason:              resp.Error.Reason,
+			Transient:           resp.Error.Transient,
+			SuggestedPageSize:   resp.Error.SuggestedPageSize,
 		}
 
 		if resp.Error.RetryAfter != nil {
@@ -55,28 +63,171 @@ func getResponse(
 		})
 	}
 
-	entityObjects, adapterErr := getEntityObjects(reverseMapping, resp.Success.Objects)
+	entityObjects, adapterErr := newEntityConverter(reverseMapping).getEntityObjects(new(rpcvalue.Allocator), resp.Success.Objects)
 
 	if adapterErr != nil {
 		return api_adapter_v1.NewGetPageResponseError(adapterErr)
 	}
 
+	warnings, adapterErr := getWarnings(resp.Success.Warnings)
+	if adapterErr != nil {
+		return api_adapter_v1.NewGetPageResponseError(adapterErr)
+	}
+
 	page := &api_adapter_v1.Page{
 		NextCursor: resp.Success.NextCursor,
 		Objects:    entityObjects.Objects,
+		Warnings:   warnings,
 	}
 
 	return api_adapter_v1.NewGetPageResponseSuccess(page)
 }
 
+// getPageBuilderResponse converts a ProtoAdapter Response and the page it wrote
+// into a GetPageResponse.
+func getPageBuilderResponse(
+	page *framework.PageBuilder,
+	resp *framework.Response,
+) (rpcResponse *api_adapter_v1.GetPageResponse) {
+	if resp.Error != nil || resp.Success == nil {
+		// No reverse mapping is needed, since there are no objects to convert.
+		return getResponse(nil, resp)
+	}
+
+	if len(resp.Success.Objects) > 0 {
+		return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+			Message: "Adapter returned objects in its response instead of writing them into the page. This is always indicative of a bug within the Adapter implementation.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	objects, err := page.Build()
+	if err != nil {
+		return api_adapter_v1.NewGetPageResponseError(&api_adapter_v1.Error{
+			Message: fmt.Sprintf("Adapter wrote an invalid page: %v. This is always indicative of a bug within the Adapter implementation.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	warnings, adapterErr := getWarnings(resp.Success.Warnings)
+	if adapterErr != nil {
+		return api_adapter_v1.NewGetPageResponseError(adapterErr)
+	}
+
+	rpcPage := &api_adapter_v1.Page{
+		NextCursor: resp.Success.NextCursor,
+		Objects:    objects,
+		Warnings:   warnings,
+	}
+
+	return api_adapter_v1.NewGetPageResponseSuccess(rpcPage)
+}
+
+// getWarnings converts adapter warnings into RPC warnings.
+// Returns an error if a warning has an unspecified or unknown code, or no
+// message.
+func getWarnings(warnings []framework.Warning) ([]*api_adapter_v1.Warning, *api_adapter_v1.Error) {
+	if len(warnings) == 0 {
+		return nil, nil
+	}
+
+	rpcWarnings := make([]*api_adapter_v1.Warning, 0, len(warnings))
+
+	for _, warning := range warnings {
+		if _, found := api_adapter_v1.WarningCode_name[int32(warning.Code)]; !found ||
+			warning.Code == api_adapter_v1.WarningCode_WARNING_CODE_UNSPECIFIED {
+			return nil, &api_adapter_v1.Error{
+				Message: fmt.Sprintf("Adapter returned a warning with an invalid code: %d. This is always indicative of a bug within the Adapter implementation.", warning.Code),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		if warning.Message == "" {
+			return nil, &api_adapter_v1.Error{
+				Message: fmt.Sprintf("Adapter returned a warning with code %s without a message. This is always indicative of a bug within the Adapter implementation.", warning.Code),
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			}
+		}
+
+		rpcWarnings = append(rpcWarnings, &api_adapter_v1.Warning{
+			Code:                warning.Code,
+			Message:             warning.Message,
+			ObjectId:            warning.ObjectID,
+			AttributeExternalId: warning.AttributeExternalID,
+		})
+	}
+
+	return rpcWarnings, nil
+}
+
+// entityConverter converts the adapter objects for an entity into RPC objects.
+// It is built once per response from an entityReverseIdMapping, so that the
+// order of the attributes and child entities and the attribute converters are
+// not recomputed for every object.
+type entityConverter struct {
+	// reverseMapping is the mapping of the entity's external IDs to IDs.
+	reverseMapping *entityReverseIdMapping
+
+	// fields is the entity's attributes and child entities, sorted by
+	// external ID in order to always return attributes and child objects in
+	// the same order.
+	fields []entityField
+}
+
+// entityField is an attribute or child entity of an entity.
+type entityField struct {
+	// externalId is the external ID of the attribute or child entity.
+	externalId string
+
+	// attribute is the config of the attribute, or nil for a child entity.
+	attribute *api_adapter_v1.AttributeConfig
+
+	// convert converts the attribute's values, or is nil for a child entity.
+	convert attributeConverter
+
+	// childEntity converts the child entity's objects, or is nil for an
+	// attribute.
+	childEntity *entityConverter
+}
+
+// newEntityConverter returns the converter for the objects of the entity with
+// the given reverse mapping.
+func newEntityConverter(reverseMapping *entityReverseIdMapping) *entityConverter {
+	c := &entityConverter{
+		reverseMapping: reverseMapping,
+		fields:         make([]entityField, 0, len(reverseMapping.Attributes)+len(reverseMapping.ChildEntities)),
+	}
+
+	for externalId, attribute := range reverseMapping.Attributes {
+		c.fields = append(c.fields, entityField{
+			externalId: externalId,
+			attribute:  attribute,
+			convert:    newAttributeConverter(attribute),
+		})
+	}
+
+	for externalId, childReverseMapping := range reverseMapping.ChildEntities {
+		c.fields = append(c.fields, entityField{
+			externalId:  externalId,
+			childEntity: newEntityConverter(childReverseMapping),
+		})
+	}
+
+	sort.Slice(c.fields, func(i, j int) bool {
+		return c.fields[i].externalId < c.fields[j].externalId
+	})
+
+	return c
+}
+
 // getEntityObjects converts an adapter list of objects for an entity into an
 // EntityObject.
-func getEntityObjects(
-	reverseMapping *entityReverseIdMapping,
+func (c *entityConverter) getEntityObjects(
+	alloc *rpcvalue.Allocator,
 	objects []framework.Object,
 ) (entityObjects *api_adapter_v1.EntityObjects, adapterErr *api_adapter_v1.Error) {
 	entityObjects = &api_adapter_v1.EntityObjects{
-		EntityId: reverseMapping.Id,
+		EntityId: c.reverseMapping.Id,
 	}
 
 	if len(objects) > 0 {
@@ -84,7 +235,7 @@ func getEntityObjects(
 
 		for _, object := range objects {
 			var entityObject *api_adapter_v1.Object
-			entityObject, adapterErr = getEntityObject(reverseMapping, object)
+			entityObject, adapterErr = c.getEntityObject(alloc, object)
 
 			if adapterErr != nil {
 				return nil, adapterErr
@@ -99,97 +250,159 @@ func getEntityObjects(
 }
 
 // getEntityObject converts an adapter Object into an RPC object.
-func getEntityObject(
-	reverseMapping *entityReverseIdMapping,
+func (c *entityConverter) getEntityObject(
+	alloc *rpcvalue.Allocator,
 	object framework.Object,
 ) (entityObject *api_adapter_v1.Object, adapterErr *api_adapter_v1.Error) {
-	entityObject = new(api_adapter_v1.Object)
+	entityObject = alloc.NewObject()
+	entityObject.Attributes = alloc.NewAttributeList(len(object))
 
-	// Iterate over the sorted externalIds, in order to always return
-	// attributes and child objects in the same order.
-	sortedExternalIds := make([]string, 0, len(object))
-	for externalId := range object {
-		sortedExternalIds = append(sortedExternalIds, externalId)
-	}
-	sort.Strings(sortedExternalIds)
+	// Count the object's entries that match a field, to detect entries with
+	// invalid external IDs without iterating over the object.
+	matched := 0
 
-	for _, externalId := range sortedExternalIds {
-		value := object[externalId]
-		switch v := value.(type) {
+	for i := range c.fields {
+		field := &c.fields[i]
 
-		case []framework.Object: // Child objects for a child entity.
-			childReverseMapping, validExternalId := reverseMapping.ChildEntities[externalId]
+		value, found := object[field.externalId]
+		if !found {
+			continue
+		}
 
-			if !validExternalId {
-				adapterErr = &api_adapter_v1.Error{
-					Message: fmt.Sprintf("Adapter returned an object for entity %s which contains child objects with an invalid entity external ID: %s. This is always indicative of a bug within the Adapter implementation.", reverseMapping.Id, externalId),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}
+		matched++
 
-				return nil, adapterErr
+		childObjects, isChildObjects := getChildObjects(value)
+
+		if field.childEntity != nil {
+			if !isChildObjects {
+				return nil, c.getEntityObjectError(object)
 			}
 
 			// As an optimization, ignore the child entity if there are no
 			// objects to return.
-			if len(v) == 0 {
+			if len(childObjects.Objects) == 0 && childObjects.NextCursor == "" {
 				continue
 			}
 
 			var childEntityObjects *api_adapter_v1.EntityObjects
-			childEntityObjects, adapterErr = getEntityObjects(childReverseMapping, v)
+			childEntityObjects, adapterErr = field.childEntity.getEntityObjects(alloc, childObjects.Objects)
 
 			if adapterErr != nil {
 				return nil, adapterErr
 			}
 
+			childEntityObjects.NextCursor = childObjects.NextCursor
+
 			entityObject.ChildObjects = append(entityObject.ChildObjects, childEntityObjects)
 
-		default: // Attribute.
-			attributeMetadata, validExternalId := reverseMapping.Attributes[externalId]
+			continue
+		}
 
-			if !validExternalId {
+		if isChildObjects {
+			return nil, c.getEntityObjectError(object)
+		}
+
+		values, valid := field.convert(alloc, value)
+		if !valid {
+			return nil, c.getEntityObjectError(object)
+		}
+
+		// As an optimization, ignore the attribute value if it is null, as
+		// it is equivalent to returning null.
+		if values == nil {
+			continue
+		}
+
+		entityObject.Attributes = append(entityObject.Attributes, alloc.NewAttribute(field.attribute.Id, values))
+	}
+
+	if matched != len(object) || len(entityObject.Attributes) == 0 {
+		return nil, c.getEntityObjectError(object)
+	}
+
+	return
+}
+
+// getChildObjects returns the given value of an Object as child objects, and
+// whether it is a value of a child entity.
+func getChildObjects(value any) (childObjects framework.ChildObjects, isChildObjects bool) {
+	switch v := value.(type) {
+	case []framework.Object:
+		return framework.ChildObjects{Objects: v}, true
+	case framework.ChildObjects:
+		return v, true
+	default:
+		return framework.ChildObjects{}, false
+	}
+}
+
+// getEntityObjectError returns the error for an invalid adapter Object.
+// This is the slow path of getEntityObject, which checks entries in the order
+// of their sorted external IDs, so that the first invalid entry is always
+// reported.
+func (c *entityConverter) getEntityObjectError(object framework.Object) (adapterErr *api_adapter_v1.Error) {
+	sortedExternalIds := make([]string, 0, len(object))
+	for externalId := range object {
+		sortedExternalIds = append(sortedExternalIds, externalId)
+	}
+	sort.Strings(sortedExternalIds)
+
+	for _, externalId := range sortedExternalIds {
+		value := object[externalId]
+		switch value.(type) {
+
+		case []framework.Object, framework.ChildObjects: // Child objects for a child entity.
+			if c.reverseMapping.ChildEntities[externalId] == nil {
 				adapterErr = &api_adapter_v1.Error{
-					Message: fmt.Sprintf("Adapter returned an object for entity %s which contains an attribute with an invalid external ID: %s. This is always indicative of a bug within the Adapter implementation.", reverseMapping.Id, externalId),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					Message:          fmt.Sprintf("Adapter returned an object for entity %s which contains child objects with an invalid entity external ID: %s. This is always indicative of a bug within the Adapter implementation.", c.reverseMapping.Id, externalId),
+					Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					EntityExternalId: externalId,
+					Reason:           framework.ViolationReasonInvalidChildEntityExternalID,
 				}
 
-				return nil, adapterErr
+				return adapterErr
 			}
 
-			adapterErr = validateAttributeValue(attributeMetadata, value)
+			// Errors in valid child objects are returned by getEntityObject
+			// before calling this function.
 
-			if adapterErr != nil {
-				return nil, adapterErr
-			}
+		default: // Attribute.
+			attributeMetadata, validExternalId := c.reverseMapping.Attributes[externalId]
 
-			var values []*api_adapter_v1.AttributeValue
-			values, adapterErr = getAttributeValues(value)
+			if !validExternalId {
+				adapterErr = &api_adapter_v1.Error{
+					Message:             fmt.Sprintf("Adapter returned an object for entity %s which contains an attribute with an invalid external ID: %s. This is always indicative of a bug within the Adapter implementation.", c.reverseMapping.Id, externalId),
+					Code:                api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					AttributeExternalId: externalId,
+					Reason:              framework.ViolationReasonInvalidAttributeExternalID,
+				}
 
-			if adapterErr != nil {
-				return nil, adapterErr
+				return adapterErr
 			}
 
-			// As an optimization, ignore the attribute value if it is null, as
-			// it is equivalent to returning null.
-			if values == nil {
-				continue
-			}
+			adapterErr = validateAttributeValue(attributeMetadata, value)
 
-			entityObject.Attributes = append(entityObject.Attributes, &api_adapter_v1.Attribute{
-				Id:     attributeMetadata.Id,
-				Values: values,
-			})
+			if adapterErr != nil {
+				return adapterErr
+			}
 		}
 	}
 
-	if len(entityObject.Attributes) == 0 {
-		adapterErr = &api_adapter_v1.Error{
-			Message: fmt.Sprintf("Adapter returned an object for entity %s which contains no non-null attributes. This is always indicative of a bug within the Adapter implementation.", reverseMapping.Id),
-			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-		}
+	adapterErr = &api_adapter_v1.Error{
+		Message: fmt.Sprintf("Adapter returned an object for entity %s which contains no non-null attributes. This is always indicative of a bug within the Adapter implementation.", c.reverseMapping.Id),
+		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+	}
 
-		return nil, adapterErr
+	return adapterErr
+}
+
+// getWarningCounts returns the number of the given warnings by warning code name.
+func getWarningCounts(warnings []framework.Warning) map[string]int {
+	counts := make(map[string]int)
+
+	for _, warning := range warnings {
+		counts[warning.Code.String()]++
 	}
 
-	return
+	return counts
 }
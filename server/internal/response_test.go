@@ -15,11 +15,14 @@
 package internal
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/internal/rpcvalue"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -57,6 +60,39 @@ func TestGetResponse(t *testing.T) {
 				},
 			},
 		},
+		"error_with_details": {
+			reverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+			},
+			resp: &framework.Response{
+				Error: &framework.Error{
+					Message:             "Datasource rejected request, returned status code: 400.",
+					Code:                api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					UpstreamStatusCode:  400,
+					UpstreamRequestID:   "req-123",
+					AttributeExternalID: "name",
+					EntityExternalID:    "users",
+					Reason:              "HTTP_BAD_REQUEST",
+					Transient:           true,
+					SuggestedPageSize:   50,
+				},
+			},
+			wantRpcResponse: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Error{
+					Error: &api_adapter_v1.Error{
+						Message:             "Datasource rejected request, returned status code: 400.",
+						Code:                api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+						UpstreamStatusCode:  400,
+						UpstreamRequestId:   "req-123",
+						AttributeExternalId: "name",
+						EntityExternalId:    "users",
+						Reason:              "HTTP_BAD_REQUEST",
+						Transient:           true,
+						SuggestedPageSize:   50,
+					},
+				},
+			},
+		},
 		"success_no_objects": {
 			reverseMapping: &entityReverseIdMapping{
 				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
@@ -103,6 +139,58 @@ func TestGetResponse(t *testing.T) {
 				},
 			},
 		},
+		"success_with_warnings": {
+			reverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"name": {
+						Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+						ExternalId: "name",
+						Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+					},
+				},
+			},
+			resp: &framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"name": "Alice"},
+					},
+					Warnings: []framework.Warning{
+						framework.NewObjectSkippedWarning("bob", errors.New("attribute name cannot be parsed into a string value")),
+						framework.NewDeprecatedAPIWarning("The v1 users API is deprecated."),
+					},
+				},
+			},
+			wantRpcResponse: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Success{
+					Success: &api_adapter_v1.Page{
+						Objects: []*api_adapter_v1.Object{
+							{
+								Attributes: []*api_adapter_v1.Attribute{
+									{
+										Id: "12268f03-f99d-476f-91cc-5fe3404e1654",
+										Values: []*api_adapter_v1.AttributeValue{
+											{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "Alice"}},
+										},
+									},
+								},
+							},
+						},
+						Warnings: []*api_adapter_v1.Warning{
+							{
+								Code:     api_adapter_v1.WarningCode_WARNING_CODE_OBJECT_SKIPPED,
+								Message:  "Skipped malformed object: attribute name cannot be parsed into a string value.",
+								ObjectId: "bob",
+							},
+							{
+								Code:    api_adapter_v1.WarningCode_WARNING_CODE_DEPRECATED_API,
+								Message: "The v1 users API is deprecated.",
+							},
+						},
+					},
+				},
+			},
+		},
 		"success_multiple_objects": {
 			reverseMapping: &entityReverseIdMapping{
 				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
@@ -367,6 +455,143 @@ func TestGetEntityObject(t *testing.T) {
 				},
 			},
 		},
+		"child_objects_with_next_cursor": {
+			reverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"id": {
+						Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+						ExternalId: "id",
+						Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+					},
+				},
+				ChildEntities: map[string]*entityReverseIdMapping{
+					"members": {
+						Id: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						Attributes: map[string]*api_adapter_v1.AttributeConfig{
+							"id": {
+								Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+								ExternalId: "id",
+								Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							},
+						},
+					},
+				},
+			},
+			object: framework.Object{
+				"id": "group-1",
+				"members": framework.ChildObjects{
+					Objects:    []framework.Object{{"id": "alice"}},
+					NextCursor: "1",
+				},
+			},
+			wantEntityObject: &api_adapter_v1.Object{
+				Attributes: []*api_adapter_v1.Attribute{
+					{
+						Id: "12268f03-f99d-476f-91cc-5fe3404e1654",
+						Values: []*api_adapter_v1.AttributeValue{
+							{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "group-1"}},
+						},
+					},
+				},
+				ChildObjects: []*api_adapter_v1.EntityObjects{
+					{
+						EntityId: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						Objects: []*api_adapter_v1.Object{
+							{
+								Attributes: []*api_adapter_v1.Attribute{
+									{
+										Id: "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+										Values: []*api_adapter_v1.AttributeValue{
+											{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "alice"}},
+										},
+									},
+								},
+							},
+						},
+						NextCursor: "1",
+					},
+				},
+			},
+		},
+		"child_objects_empty_with_next_cursor": {
+			reverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"id": {
+						Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+						ExternalId: "id",
+						Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+					},
+				},
+				ChildEntities: map[string]*entityReverseIdMapping{
+					"members": {
+						Id: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						Attributes: map[string]*api_adapter_v1.AttributeConfig{
+							"id": {
+								Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+								ExternalId: "id",
+								Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							},
+						},
+					},
+				},
+			},
+			object: framework.Object{
+				"id":      "group-1",
+				"members": framework.ChildObjects{NextCursor: "1"},
+			},
+			wantEntityObject: &api_adapter_v1.Object{
+				Attributes: []*api_adapter_v1.Attribute{
+					{
+						Id: "12268f03-f99d-476f-91cc-5fe3404e1654",
+						Values: []*api_adapter_v1.AttributeValue{
+							{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "group-1"}},
+						},
+					},
+				},
+				ChildObjects: []*api_adapter_v1.EntityObjects{
+					{
+						EntityId:   "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						NextCursor: "1",
+					},
+				},
+			},
+		},
+		"invalid_child_objects_with_next_cursor_entity_external_id": {
+			reverseMapping: &entityReverseIdMapping{
+				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"id": {
+						Id:         "12268f03-f99d-476f-91cc-5fe3404e1654",
+						ExternalId: "id",
+						Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+					},
+				},
+				ChildEntities: map[string]*entityReverseIdMapping{
+					"members": {
+						Id: "5d7e2d8f-4b4f-4f0b-8d0e-6a3a6f0f6b1e",
+						Attributes: map[string]*api_adapter_v1.AttributeConfig{
+							"id": {
+								Id:         "9b0f1a1c-7e43-4f5c-9d2c-1b7f5a2e0c3d",
+								ExternalId: "id",
+								Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+							},
+						},
+					},
+				},
+			},
+			object: framework.Object{
+				"id":     "group-1",
+				"owners": framework.ChildObjects{NextCursor: "1"},
+			},
+			wantAdapterErr: &api_adapter_v1.Error{
+				Message:          "Adapter returned an object for entity 00d58abb-0b80-4745-927a-af9b2fb612dd which contains child objects with an invalid entity external ID: owners. This is always indicative of a bug within the Adapter implementation.",
+				Code:             11, // ERROR_CODE_INTERNAL
+				EntityExternalId: "owners",
+				Reason:           framework.ViolationReasonInvalidChildEntityExternalID,
+			},
+		},
 		"one_attribute_string_list_multiple_values": {
 			reverseMapping: &entityReverseIdMapping{
 				Id: "00d58abb-0b80-4745-927a-af9b2fb612dd",
@@ -439,7 +664,7 @@ func TestGetEntityObject(t *testing.T) {
 						Id: "12268f03-f99d-476f-91cc-5fe3404e1654",
 						Values: []*api_adapter_v1.AttributeValue{
 							{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "John Doe"}},
-							nullValue,
+							rpcvalue.Null,
 						},
 					},
 				},
@@ -517,8 +742,10 @@ func TestGetEntityObject(t *testing.T) {
 				"email": "john@doe.org",
 			},
 			wantAdapterErr: &api_adapter_v1.Error{
-				Message: "Adapter returned an object for entity 00d58abb-0b80-4745-927a-af9b2fb612dd which contains an attribute with an invalid external ID: email. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
+				Message:             "Adapter returned an object for entity 00d58abb-0b80-4745-927a-af9b2fb612dd which contains an attribute with an invalid external ID: email. This is always indicative of a bug within the Adapter implementation.",
+				Code:                11, // ERROR_CODE_INTERNAL
+				AttributeExternalId: "email",
+				Reason:              "INVALID_ATTRIBUTE_EXTERNAL_ID",
 			},
 		},
 		"invalid_attribute_value_type": {
@@ -759,8 +986,10 @@ func TestGetEntityObject(t *testing.T) {
 				},
 			},
 			wantAdapterErr: &api_adapter_v1.Error{
-				Message: "Adapter returned an object for entity 00d58abb-0b80-4745-927a-af9b2fb612dd which contains child objects with an invalid entity external ID: entitlements. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
+				Message:          "Adapter returned an object for entity 00d58abb-0b80-4745-927a-af9b2fb612dd which contains child objects with an invalid entity external ID: entitlements. This is always indicative of a bug within the Adapter implementation.",
+				Code:             11, // ERROR_CODE_INTERNAL
+				EntityExternalId: "entitlements",
+				Reason:           "INVALID_CHILD_ENTITY_EXTERNAL_ID",
 			},
 		},
 		"invalid_attribute_external_id_in_child_object": {
@@ -795,17 +1024,129 @@ func TestGetEntityObject(t *testing.T) {
 				},
 			},
 			wantAdapterErr: &api_adapter_v1.Error{
-				Message: "Adapter returned an object for entity 05182a15-2451-4551-80ef-606fd05c1cc2 which contains an attribute with an invalid external ID: displayName. This is always indicative of a bug within the Adapter implementation.",
-				Code:    11, // ERROR_CODE_INTERNAL
+				Message:             "Adapter returned an object for entity 05182a15-2451-4551-80ef-606fd05c1cc2 which contains an attribute with an invalid external ID: displayName. This is always indicative of a bug within the Adapter implementation.",
+				Code:                11, // ERROR_CODE_INTERNAL
+				AttributeExternalId: "displayName",
+				Reason:              "INVALID_ATTRIBUTE_EXTERNAL_ID",
 			},
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotEntityObject, gotAdapterErr := getEntityObject(tc.reverseMapping, tc.object)
+			gotEntityObject, gotAdapterErr := newEntityConverter(tc.reverseMapping).getEntityObject(new(rpcvalue.Allocator), tc.object)
 			AssertDeepEqual(t, tc.wantEntityObject, gotEntityObject)
 			AssertDeepEqual(t, tc.wantAdapterErr, gotAdapterErr)
 		})
 	}
 }
+
+func BenchmarkGetResponse(b *testing.B) {
+	reverseMapping := &entityReverseIdMapping{
+		Id: "users",
+		Attributes: map[string]*api_adapter_v1.AttributeConfig{
+			"id":          {Id: "id", ExternalId: "id", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, UniqueId: true},
+			"displayName": {Id: "displayName", ExternalId: "displayName", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			"email":       {Id: "email", ExternalId: "email", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			"enabled":     {Id: "enabled", ExternalId: "enabled", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_BOOL},
+			"loginCount":  {Id: "loginCount", ExternalId: "loginCount", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_INT64},
+			"manager":     {Id: "manager", ExternalId: "manager", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+			"lastLogin":   {Id: "lastLogin", ExternalId: "lastLogin", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_DATE_TIME},
+			"proxyAddresses": {
+				Id: "proxyAddresses", ExternalId: "proxyAddresses", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true,
+			},
+		},
+		ChildEntities: map[string]*entityReverseIdMapping{
+			"memberOf": {
+				Id: "memberOf",
+				Attributes: map[string]*api_adapter_v1.AttributeConfig{
+					"id":   {Id: "id", ExternalId: "id", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+					"type": {Id: "type", ExternalId: "type", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING},
+				},
+			},
+		},
+	}
+
+	groupReverseMapping := &entityReverseIdMapping{
+		Id: "groups",
+		Attributes: map[string]*api_adapter_v1.AttributeConfig{
+			"id": {Id: "id", ExternalId: "id", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, UniqueId: true},
+			"member": {
+				Id: "member", ExternalId: "member", Type: api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING, List: true,
+			},
+		},
+	}
+
+	lastLogin := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// A realistic page of 10k users, each with a few child objects.
+	users := make([]framework.Object, 10_000)
+	for i := range users {
+		memberOf := make([]framework.Object, 5)
+		for j := range memberOf {
+			memberOf[j] = framework.Object{
+				"id":   fmt.Sprintf("group-%d", j),
+				"type": "security",
+			}
+		}
+
+		users[i] = framework.Object{
+			"id":             fmt.Sprintf("user-%d", i),
+			"displayName":    fmt.Sprintf("User %d", i),
+			"email":          fmt.Sprintf("user-%d@example.com", i),
+			"enabled":        i%2 == 0,
+			"loginCount":     int64(i),
+			"manager":        (*string)(nil),
+			"lastLogin":      lastLogin,
+			"proxyAddresses": []string{fmt.Sprintf("smtp:user-%d@example.com", i), fmt.Sprintf("SMTP:u%d@example.com", i)},
+			"memberOf":       memberOf,
+		}
+	}
+
+	// A page of LDAP groups with many members each.
+	groups := make([]framework.Object, 10)
+	for i := range groups {
+		members := make([]string, 10_000)
+		for j := range members {
+			members[j] = fmt.Sprintf("CN=User %d,OU=Users,DC=example,DC=com", j)
+		}
+
+		groups[i] = framework.Object{
+			"id":     fmt.Sprintf("group-%d", i),
+			"member": members,
+		}
+	}
+
+	benchmarks := map[string]struct {
+		reverseMapping *entityReverseIdMapping
+		objects        []framework.Object
+	}{
+		"users_with_child_objects": {
+			reverseMapping: reverseMapping,
+			objects:        users,
+		},
+		"groups_with_many_members": {
+			reverseMapping: groupReverseMapping,
+			objects:        groups,
+		},
+	}
+
+	for name, bm := range benchmarks {
+		resp := &framework.Response{
+			Success: &framework.Page{
+				Objects: bm.objects,
+			},
+		}
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				rpcResp := getResponse(bm.reverseMapping, resp)
+				if rpcResp.GetError() != nil {
+					b.Fatalf("Unexpected error: %v", rpcResp.GetError())
+				}
+			}
+		})
+	}
+}
@@ -17,20 +17,62 @@ package internal
 import (
 	"context"
 	"fmt"
+	"io"
 	"slices"
 	"sync"
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
 	"github.com/sgnl-ai/adapter-framework/pkg/connector"
 	"github.com/sgnl-ai/adapter-framework/pkg/logs"
+	"github.com/sgnl-ai/adapter-framework/pkg/secrets"
 	"google.golang.org/grpc/codes"
 	grpc_metadata "google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-type AdapterGetPageFunc func(ctx context.Context, req *api_adapter_v1.GetPageRequest) (framework.Response, *entityReverseIdMapping)
+type AdapterGetPageFunc func(ctx context.Context, req *api_adapter_v1.GetPageRequest) adapterResult
+
+type AdapterValidateConfigFunc func(ctx context.Context, datasource *api_adapter_v1.DatasourceConfig) *api_adapter_v1.ValidateConfigResponse
+
+// adapterResult is the result of a call to an AdapterGetPageFunc.
+type adapterResult struct {
+	// Response is the response returned by the high-level Adapter implementation.
+	Response framework.Response
+
+	// ReverseMapping is the mapping of the requested entity's external IDs to IDs,
+	// or nil if the request is invalid.
+	ReverseMapping *entityReverseIdMapping
+
+	// PrefetchDisabled indicates whether the Adapter or the datasource config opted
+	// out of prefetching the next page.
+	PrefetchDisabled bool
+
+	// Page contains the objects written by a ProtoAdapter, or is nil for a
+	// high-level Adapter.
+	Page *framework.PageBuilder
+
+	// SplitPage splits the page of the Response if it is too large, or is nil if
+	// the Adapter doesn't implement framework.PageSplitter.
+	SplitPage pageSplitFunc
+}
+
+// getResponse converts the result into a GetPageResponse, which serialized size
+// is limited to maxBytes if positive.
+func (r *adapterResult) getResponse(maxBytes int) *api_adapter_v1.GetPageResponse {
+	var resp *api_adapter_v1.GetPageResponse
+
+	if r.Page == nil {
+		resp = getResponse(r.ReverseMapping, &r.Response)
+	} else {
+		resp = getPageBuilderResponse(r.Page, &r.Response)
+	}
+
+	return limitResponseSize(resp, maxBytes, r.SplitPage)
+}
 
 // Server is an implementation of the AdapterServer gRPC service which
 // delegates the implementation of the RPCs to high-level Adapter
@@ -45,6 +87,15 @@ type Server struct {
 	// specified on the Adapter object created in SGNL.
 	AdapterGetPageFuncs map[string]AdapterGetPageFunc
 
+	// AdapterCapabilities maps each datasource type in AdapterGetPageFuncs to the
+	// capabilities of its high-level Adapter implementation.
+	AdapterCapabilities map[string]*api_adapter_v1.DatasourceCapabilities
+
+	// AdapterValidateConfigFuncs maps each datasource type in AdapterGetPageFuncs to a
+	// function that migrates and validates datasource configs for its high-level Adapter
+	// implementation.
+	AdapterValidateConfigFuncs map[string]AdapterValidateConfigFunc
+
 	// Tokens contains a lists of valid auth tokens for this server. This list of Tokens
 	// is populated when the server is created based on the JSON-encoded value in the file
 	// located under the path contained in the `AUTH_TOKENS_PATH` environment variable and is
@@ -58,17 +109,112 @@ type Server struct {
 	// Logger is an optional logger that can be used throughout the server and passed to adapters
 	// via the context in a GetPage request.
 	Logger logs.Logger
+
+	// SoftDeadlineMargin is the safety margin between the soft deadline passed to adapters
+	// in the context of a GetPage request and the deadline of the RPC.
+	// Adapters may return the objects collected so far once the soft deadline has passed.
+	SoftDeadlineMargin time.Duration
+
+	// ResponseCache is an optional cache of GetPage responses used to serve retried
+	// GetPage requests without calling the adapter again.
+	ResponseCache *ResponseCache
+
+	// SecretResolver is an optional resolver of the secret references in datasource configs.
+	// If nil, secret references are not resolved.
+	SecretResolver secrets.Resolver
+
+	// Prefetcher is an optional prefetcher used to get the next page of an entity in
+	// the background after returning a page with a next cursor.
+	Prefetcher *Prefetcher
+
+	// CircuitBreaker is the optional config of the circuit breakers of the high-level
+	// Adapter implementations, which fail requests fast for datasources that failed
+	// repeatedly. Each Adapter gets its own circuit breaker, which config may be
+	// overridden when registering the Adapter. If nil, circuit breakers are only
+	// enabled for the Adapters registered with a config.
+	CircuitBreaker *CircuitBreakerConfig
+
+	// ResourcePool contains the per-datasource resources of the high-level Adapter
+	// implementations implementing framework.Lifecycle.
+	// If nil, a pool with the default idle timeout is created when the first such
+	// Adapter is registered.
+	ResourcePool *ResourcePool
+
+	// AuditSink is an optional sink recording which data was returned to which client
+	// by every authenticated GetPage request.
+	AuditSink AuditSink
+
+	// MaxPageBytes is the maximum serialized size of a GetPage response. Larger pages
+	// are split if the Adapter implements framework.PageSplitter, and fail with a
+	// PAGE_TOO_LARGE error suggesting a smaller page size otherwise.
+	// If not positive, the size of pages is not limited.
+	MaxPageBytes int
+
+	// GzipCompression indicates whether GetPage responses are compressed with gzip
+	// for the clients supporting it.
+	GzipCompression bool
 }
 
 func (s *Server) GetPage(ctx context.Context, req *api_adapter_v1.GetPageRequest) (*api_adapter_v1.GetPageResponse, error) {
-	if err := s.validateAuthenticationToken(ctx); err != nil {
+	start := time.Now()
+
+	tokenIdentity, err := s.authenticate(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	resp := s.getPage(ctx, req)
+
+	if s.GzipCompression {
+		// Fails only if the context is not the context of a gRPC call, e.g. in
+		// tests, in which case there is nothing to compress.
+		_ = setGzipCompressor(ctx)
+	}
+
+	if s.AuditSink != nil {
+		if err := s.AuditSink.Audit(newAuditRecord(tokenIdentity, start, req, resp)); err != nil && s.Logger != nil {
+			s.Logger.Error("Failed to record the audit record of the request",
+				logs.DatasourceID(req.GetDatasource().GetId()),
+				logs.DatasourceType(req.GetDatasource().GetType()),
+				logs.Error(err),
+			)
+		}
+	}
+
+	return resp, nil
+}
+
+// getPage returns the response to the given authenticated request.
+func (s *Server) getPage(ctx context.Context, req *api_adapter_v1.GetPageRequest) *api_adapter_v1.GetPageResponse {
 	if adapterGetPageFunc, ok := s.AdapterGetPageFuncs[req.Datasource.Type]; ok {
-		adapterResponse, reverseMapping := adapterGetPageFunc(ctx, req)
+		getPage := func() (*api_adapter_v1.GetPageResponse, bool) {
+			if s.Prefetcher != nil {
+				if resp := s.Prefetcher.Take(ctx, req); resp != nil {
+					s.prefetchNextPage(req, resp, adapterGetPageFunc)
+
+					return resp, true
+				}
+			}
+
+			result := adapterGetPageFunc(ctx, req)
+			resp := result.getResponse(s.MaxPageBytes)
+
+			if s.Prefetcher != nil && !result.PrefetchDisabled {
+				s.prefetchNextPage(req, resp, adapterGetPageFunc)
+			}
+
+			// Don't cache the response if the request was canceled or timed out while
+			// the adapter was running, since the response likely reflects that.
+			return resp, ctx.Err() == nil
+		}
 
-		return getResponse(reverseMapping, &adapterResponse), nil
+		if s.ResponseCache != nil {
+			return s.ResponseCache.Do(ctx, req, getPage)
+		}
+
+		resp, _ := getPage()
+
+		return resp
 	}
 
 	adapterErr := &api_adapter_v1.Error{
@@ -76,7 +222,45 @@ func (s *Server) GetPage(ctx context.Context, req *api_adapter_v1.GetPageRequest
 		Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
 	}
 
-	return api_adapter_v1.NewGetPageResponseError(adapterErr), nil
+	return api_adapter_v1.NewGetPageResponseError(adapterErr)
+}
+
+// prefetchNextPage starts prefetching the page following the given response to the
+// given request, if the response is successful and contains a next cursor.
+func (s *Server) prefetchNextPage(
+	req *api_adapter_v1.GetPageRequest,
+	resp *api_adapter_v1.GetPageResponse,
+	adapterGetPageFunc AdapterGetPageFunc,
+) {
+	nextCursor := resp.GetSuccess().GetNextCursor()
+	if nextCursor == "" {
+		return
+	}
+
+	nextReq := proto.Clone(req).(*api_adapter_v1.GetPageRequest)
+	nextReq.Cursor = nextCursor
+
+	s.Prefetcher.Start(nextReq, func(ctx context.Context) *api_adapter_v1.GetPageResponse {
+		result := adapterGetPageFunc(ctx, nextReq)
+
+		return result.getResponse(s.MaxPageBytes)
+	})
+}
+
+// Close cancels the background work of the server, closes the datasource
+// resources and closes the AuditSink if it implements io.Closer.
+func (s *Server) Close() {
+	if s.Prefetcher != nil {
+		s.Prefetcher.Close()
+	}
+
+	if s.ResourcePool != nil {
+		s.ResourcePool.Close()
+	}
+
+	if closer, ok := s.AuditSink.(io.Closer); ok {
+		closer.Close()
+	}
 }
 
 // validateAuthenticationToken verifies the request has the correct token to access the
@@ -84,40 +268,159 @@ func (s *Server) GetPage(ctx context.Context, req *api_adapter_v1.GetPageRequest
 // specified in the file located at AUTH_TOKENS_PATH.
 // Otherwise, will return an error.
 func (s *Server) validateAuthenticationToken(ctx context.Context) error {
+	_, err := s.authenticate(ctx)
+
+	return err
+}
+
+// authenticate verifies the request has the correct token to access the adapter,
+// like validateAuthenticationToken, and returns the identity of the token, which
+// can be recorded without revealing the token.
+func (s *Server) authenticate(ctx context.Context) (tokenIdentity string, err error) {
 	metadata, ok := grpc_metadata.FromIncomingContext(ctx)
 	if !ok {
-		return status.Error(codes.Unauthenticated, "invalid or missing token")
+		return "", status.Error(codes.Unauthenticated, "invalid or missing token")
 	}
 
 	requestTokens := metadata.Get("token")
 	if len(requestTokens) != 1 {
-		return status.Error(codes.Unauthenticated, "invalid or missing token")
+		return "", status.Error(codes.Unauthenticated, "invalid or missing token")
 	}
 
 	s.TokensMutex.RLock()
 	defer s.TokensMutex.RUnlock()
 
 	if slices.Contains(s.Tokens, requestTokens[0]) {
-		return nil
+		return getTokenIdentity(requestTokens[0]), nil
 	}
 
-	return status.Error(codes.Unauthenticated, "invalid or missing token")
+	return "", status.Error(codes.Unauthenticated, "invalid or missing token")
+}
+
+// AdapterOptions configures how requests are handled for a registered Adapter.
+type AdapterOptions struct {
+	// StrictConfig indicates whether datasource configs containing fields that
+	// are unknown in the adapter's Config type are rejected.
+	StrictConfig bool
+
+	// ConfigMigrations upgrade datasource configs to the latest version before
+	// they are parsed.
+	ConfigMigrations config.Migrations
+
+	// CircuitBreaker overrides the server's CircuitBreaker config for the adapter.
+	CircuitBreaker *CircuitBreakerConfig
 }
 
+// AdapterOption configures how requests are handled for a registered Adapter.
+type AdapterOption func(*AdapterOptions)
+
 // RegisterAdapter registers a new high-level Adapter implementation with the server.
 // The Config type parameter is the type of the config object that will be passed to
 // the high-level Adapter implementation.
 //
 // If this function is called with the datasource type of an already-registered Adapter,
 // it will return an error.
-func RegisterAdapter[Config any](s *Server, datasourceType string, adapter framework.Adapter[Config]) error {
+func RegisterAdapter[Config any](s *Server, datasourceType string, adapter framework.Adapter[Config], opts ...AdapterOption) error {
+	getPage := func(ctx context.Context, request *framework.Request[Config], _ *framework.PageBuilder) framework.Response {
+		return adapter.GetPage(ctx, request)
+	}
+
+	return registerAdapter(s, datasourceType, adapter, getPage, false, opts...)
+}
+
+// RegisterProtoAdapter registers a new ProtoAdapter implementation with the server.
+// The Config type parameter is the type of the config object that will be passed to
+// the ProtoAdapter implementation.
+//
+// If this function is called with the datasource type of an already-registered Adapter,
+// it will return an error.
+func RegisterProtoAdapter[Config any](s *Server, datasourceType string, adapter framework.ProtoAdapter[Config], opts ...AdapterOption) error {
+	return registerAdapter(s, datasourceType, adapter, adapter.GetPage, true, opts...)
+}
+
+// registerAdapter registers an Adapter or ProtoAdapter implementation with the server,
+// which gets pages by calling getPage.
+// If writesPage is true, getPage writes objects into a PageBuilder instead of returning
+// them.
+func registerAdapter[Config any](
+	s *Server,
+	datasourceType string,
+	adapter any,
+	getPage func(ctx context.Context, request *framework.Request[Config], page *framework.PageBuilder) framework.Response,
+	writesPage bool,
+	opts ...AdapterOption,
+) error {
 	// Check for duplicate datasource types
 	if _, ok := s.AdapterGetPageFuncs[datasourceType]; ok {
 		return fmt.Errorf("duplicate datasource type provided: %s", datasourceType)
 	}
 
-	s.AdapterGetPageFuncs[datasourceType] = func(ctx context.Context, req *api_adapter_v1.GetPageRequest) (framework.Response, *entityReverseIdMapping) {
-		adapterRequest, reverseMapping, adapterErr := getAdapterRequest[Config](req)
+	configSchema, err := getConfigSchema[Config]()
+	if err != nil {
+		return fmt.Errorf("failed to generate the config JSON Schema for datasource type %s: %w", datasourceType, err)
+	}
+
+	if s.AdapterCapabilities == nil {
+		s.AdapterCapabilities = make(map[string]*api_adapter_v1.DatasourceCapabilities)
+	}
+
+	if s.AdapterValidateConfigFuncs == nil {
+		s.AdapterValidateConfigFuncs = make(map[string]AdapterValidateConfigFunc)
+	}
+
+	var adapterOpts AdapterOptions
+	for _, opt := range opts {
+		opt(&adapterOpts)
+	}
+
+	s.AdapterCapabilities[datasourceType] = &api_adapter_v1.DatasourceCapabilities{
+		Type:          datasourceType,
+		ConfigSchema:  configSchema,
+		ConfigVersion: adapterOpts.ConfigMigrations.Version(),
+	}
+
+	if lister, ok := adapter.(framework.EntityLister); ok {
+		s.AdapterCapabilities[datasourceType].Entities = getEntityCapabilities(lister.Entities())
+	}
+
+	var breaker *CircuitBreaker
+
+	switch {
+	case adapterOpts.CircuitBreaker != nil:
+		breaker = NewCircuitBreaker(*adapterOpts.CircuitBreaker)
+	case s.CircuitBreaker != nil:
+		breaker = NewCircuitBreaker(*s.CircuitBreaker)
+	}
+
+	lifecycle, hasLifecycle := adapter.(framework.Lifecycle[Config])
+	if hasLifecycle && s.ResourcePool == nil {
+		s.ResourcePool = NewResourcePool(DefaultResourceIdleTimeout)
+	}
+
+	splitter, isSplitter := adapter.(framework.PageSplitter[Config])
+
+	var adapterPrefetchDisabled bool
+	if optOut, ok := adapter.(framework.PrefetchOptOut); ok {
+		adapterPrefetchDisabled = optOut.PrefetchDisabled()
+	}
+
+	s.AdapterValidateConfigFuncs[datasourceType] = func(
+		ctx context.Context,
+		datasource *api_adapter_v1.DatasourceConfig,
+	) *api_adapter_v1.ValidateConfigResponse {
+		return validateConfig[Config](ctx, datasource, &requestOptions{
+			secretResolver: s.SecretResolver,
+			strictConfig:   adapterOpts.StrictConfig,
+			migrations:     adapterOpts.ConfigMigrations,
+		})
+	}
+
+	s.AdapterGetPageFuncs[datasourceType] = func(ctx context.Context, req *api_adapter_v1.GetPageRequest) adapterResult {
+		adapterRequest, reverseMapping, adapterErr := getAdapterRequest[Config](ctx, req, &requestOptions{
+			secretResolver: s.SecretResolver,
+			strictConfig:   adapterOpts.StrictConfig,
+			migrations:     adapterOpts.ConfigMigrations,
+		})
 		if adapterErr != nil {
 			var adapterErrRetryAfter *time.Duration
 
@@ -126,34 +429,38 @@ func RegisterAdapter[Config any](s *Server, datasourceType string, adapter frame
 				adapterErrRetryAfter = &d
 			}
 
-			return framework.NewGetPageResponseError(&framework.Error{
-				Message:    adapterErr.Message,
-				Code:       adapterErr.Code,
-				RetryAfter: adapterErrRetryAfter,
-			}), nil
+			return adapterResult{
+				Response: framework.NewGetPageResponseError(&framework.Error{
+					Message:    adapterErr.Message,
+					Code:       adapterErr.Code,
+					RetryAfter: adapterErrRetryAfter,
+				}),
+			}
 		}
 
-		if ci := req.Datasource.GetConnectorInfo(); ci != nil {
-			newCtx, err := connector.WithContext(ctx, connector.ConnectorInfo{
-				ID:       ci.Id,
-				TenantID: ci.TenantId,
-				ClientID: ci.ClientId,
-			})
+		if adapterRequest.ConnectorInfo != nil {
+			newCtx, err := connector.WithContext(ctx, *adapterRequest.ConnectorInfo)
 			if err != nil {
-				return framework.NewGetPageResponseError(&framework.Error{
-					Message: fmt.Sprintf("Error creating connector context, %v.", err),
-					Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
-				}), nil
+				return adapterResult{
+					Response: framework.NewGetPageResponseError(&framework.Error{
+						Message: fmt.Sprintf("Error creating connector context, %v.", err),
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					}),
+				}
 			}
 			ctx = newCtx
 		}
 
+		ctx = framework.WithSoftDeadline(ctx, s.SoftDeadlineMargin)
+
 		// Create a child logger with request fields and add it to the context.
 		// Note: Cursor is intentionally not logged here to avoid exposing sensitive data
 		// (URLs with secrets, usernames, group names, IDs, etc.).
 		// Cursor fields should be selectively logged from individual adapters.
+		var requestLogger logs.Logger
+
 		if s.Logger != nil {
-			requestLogger := s.Logger.With(
+			requestLogger = s.Logger.With(
 				logs.RequestPageSize(req.PageSize),
 				logs.TenantID(req.TenantId),
 				logs.ClientID(req.ClientId),
@@ -167,7 +474,90 @@ func RegisterAdapter[Config any](s *Server, datasourceType string, adapter frame
 			ctx = logs.NewContextWithLogger(ctx, requestLogger)
 		}
 
-		return adapter.GetPage(ctx, adapterRequest), reverseMapping
+		var resp framework.Response
+
+		if breaker != nil {
+			done, retryAfter, allowed := breaker.Allow(req.Datasource.Type, req.Datasource.Address, requestLogger)
+			if !allowed {
+				return adapterResult{
+					Response: framework.NewGetPageResponseError(&framework.Error{
+						Message:    "Datasource is unavailable after repeated failures. Requests are failing fast until it recovers.",
+						Code:       api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+						RetryAfter: &retryAfter,
+					}),
+				}
+			}
+
+			defer func() {
+				done(getCircuitOutcome(ctx, &resp))
+			}()
+		}
+
+		if hasLifecycle {
+			resource, release, initErr := s.ResourcePool.Acquire(ctx, req, func() (any, *framework.Error) {
+				return lifecycle.Init(ctx, adapterRequest)
+			}, lifecycle.Close)
+			if initErr != nil {
+				resp = framework.NewGetPageResponseError(initErr)
+
+				return adapterResult{
+					Response: resp,
+				}
+			}
+
+			defer release()
+
+			ctx = framework.WithDatasourceResource(ctx, resource)
+		}
+
+		var page *framework.PageBuilder
+		if writesPage {
+			page = framework.NewPageBuilder(req.Entity)
+		}
+
+		resp = getPage(ctx, adapterRequest, page)
+
+		var objectCount int
+
+		switch {
+		case page != nil:
+			objectCount = page.Len()
+		case resp.Success != nil:
+			objectCount = len(resp.Success.Objects)
+		}
+
+		if requestLogger != nil && resp.Success != nil && framework.IsPartialPage(ctx) {
+			requestLogger.Info("Adapter returned a partial page before the request deadline",
+				logs.PageObjectCount(objectCount),
+			)
+		}
+
+		if requestLogger != nil && resp.Success != nil && len(resp.Success.Warnings) > 0 {
+			requestLogger.Info("Adapter returned a page with warnings",
+				logs.PageObjectCount(objectCount),
+				logs.PageWarningCounts(getWarningCounts(resp.Success.Warnings)),
+			)
+		}
+
+		prefetchDisabled := adapterPrefetchDisabled
+		if optOut, ok := any(adapterRequest.Config).(framework.PrefetchOptOut); ok && adapterRequest.Config != nil && !prefetchDisabled {
+			prefetchDisabled = optOut.PrefetchDisabled()
+		}
+
+		var splitPage pageSplitFunc
+		if isSplitter && resp.Success != nil {
+			splitPage = func(n int) (string, bool) {
+				return splitter.SplitPage(ctx, adapterRequest, resp.Success, n)
+			}
+		}
+
+		return adapterResult{
+			Response:         resp,
+			ReverseMapping:   reverseMapping,
+			PrefetchDisabled: prefetchDisabled,
+			Page:             page,
+			SplitPage:        splitPage,
+		}
 	}
 
 	return nil
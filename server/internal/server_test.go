@@ -16,6 +16,8 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -690,3 +692,456 @@ func TestServer_GetPage_WithLogger(t *testing.T) {
 		}
 	}
 }
+
+type MockProtoAdapter struct {
+	Write    func(page *framework.PageBuilder)
+	Response framework.Response
+}
+
+func (a *MockProtoAdapter) GetPage(
+	ctx context.Context, request *framework.Request[TestConfigA], page *framework.PageBuilder,
+) framework.Response {
+	a.Write(page)
+
+	return a.Response
+}
+
+func TestServer_GetPage_ProtoAdapter(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	writeUsers := func(page *framework.PageBuilder) {
+		name := page.Entity().Attribute("name")
+
+		page.AddObject().SetString(name, "Alice")
+		page.AddObject().SetString(name, "Bob")
+	}
+
+	tests := map[string]struct {
+		adapter  *MockProtoAdapter
+		wantResp *api_adapter_v1.GetPageResponse
+	}{
+		"success": {
+			adapter: &MockProtoAdapter{
+				Write: writeUsers,
+				Response: framework.Response{
+					Success: &framework.Page{
+						NextCursor: "next cursor",
+					},
+				},
+			},
+			wantResp: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Success{
+					Success: &api_adapter_v1.Page{
+						Objects: []*api_adapter_v1.Object{
+							{
+								Attributes: []*api_adapter_v1.Attribute{
+									{
+										Id: "attr-123",
+										Values: []*api_adapter_v1.AttributeValue{
+											{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "Alice"}},
+										},
+									},
+								},
+							},
+							{
+								Attributes: []*api_adapter_v1.Attribute{
+									{
+										Id: "attr-123",
+										Values: []*api_adapter_v1.AttributeValue{
+											{Value: &api_adapter_v1.AttributeValue_StringValue{StringValue: "Bob"}},
+										},
+									},
+								},
+							},
+						},
+						NextCursor: "next cursor",
+					},
+				},
+			},
+		},
+		"error": {
+			adapter: &MockProtoAdapter{
+				Write: writeUsers,
+				Response: framework.Response{
+					Error: &framework.Error{
+						Message: "Failed to get page.",
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+					},
+				},
+			},
+			wantResp: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Error{
+					Error: &api_adapter_v1.Error{
+						Message: "Failed to get page.",
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+					},
+				},
+			},
+		},
+		"objects_in_response": {
+			adapter: &MockProtoAdapter{
+				Write: writeUsers,
+				Response: framework.Response{
+					Success: &framework.Page{
+						Objects: []framework.Object{
+							{"name": "Carol"},
+						},
+					},
+				},
+			},
+			wantResp: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Error{
+					Error: &api_adapter_v1.Error{
+						Message: "Adapter returned objects in its response instead of writing them into the page. This is always indicative of a bug within the Adapter implementation.",
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					},
+				},
+			},
+		},
+		"invalid_page": {
+			adapter: &MockProtoAdapter{
+				Write: func(page *framework.PageBuilder) {
+					page.AddObject().SetInt64(page.Entity().Attribute("name"), 1)
+				},
+				Response: framework.Response{
+					Success: &framework.Page{},
+				},
+			},
+			wantResp: &api_adapter_v1.GetPageResponse{
+				Response: &api_adapter_v1.GetPageResponse_Error{
+					Error: &api_adapter_v1.Error{
+						Message: "Adapter wrote an invalid page: value with type int64 (list=false) written for attribute name with type string (list=false). This is always indicative of a bug within the Adapter implementation.",
+						Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+				"token": validTokens,
+			})
+
+			s := &Server{
+				Tokens:              validTokens,
+				AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+				Logger:              logs.NewMockLogger(),
+			}
+
+			if err := RegisterProtoAdapter(s, "Mock-1.0.1", tc.adapter); err != nil {
+				t.Fatal(err)
+			}
+
+			req := &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{
+					Id:      "datasource-789",
+					Type:    "Mock-1.0.1",
+					Config:  []byte(`{"a":"a value"}`),
+					Address: "http://example.com/",
+				},
+				Entity: &api_adapter_v1.EntityConfig{
+					Id:         "entity-abc",
+					ExternalId: "users",
+					Attributes: []*api_adapter_v1.AttributeConfig{
+						{
+							Id:         "attr-123",
+							ExternalId: "name",
+							Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+						},
+					},
+				},
+				PageSize: 50,
+			}
+
+			gotResp, gotError := s.GetPage(ctx, req)
+			if gotError != nil {
+				t.Fatalf("GetPage returned error: %v", gotError)
+			}
+
+			AssertDeepEqual(t, tc.wantResp, gotResp)
+		})
+	}
+}
+
+type MockSplitAdapter struct {
+	MockAdapterA
+	SplitN int
+}
+
+func (a *MockSplitAdapter) SplitPage(
+	ctx context.Context, request *framework.Request[TestConfigA], page *framework.Page, n int,
+) (string, bool) {
+	a.SplitN = n
+
+	return request.Cursor + "+" + strconv.Itoa(n), true
+}
+
+func TestServer_GetPage_MaxPageBytes(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	adapter := &MockSplitAdapter{
+		MockAdapterA: MockAdapterA{
+			Response: framework.Response{
+				Success: &framework.Page{
+					Objects: []framework.Object{
+						{"name": "Alice"},
+						{"name": "Bob"},
+						{"name": "Carol"},
+					},
+					NextCursor: "next",
+				},
+			},
+		},
+	}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+		Logger:              logs.NewMockLogger(),
+		// Fits the first 2 objects and the next cursor.
+		MaxPageBytes:    60,
+		GzipCompression: true,
+	}
+
+	if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:      "datasource-789",
+			Type:    "Mock-1.0.1",
+			Config:  []byte(`{"a":"a value"}`),
+			Address: "http://example.com/",
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "entity-abc",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "attr-123",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 3,
+		Cursor:   "cursor",
+	}
+
+	gotResp, err := s.GetPage(ctx, req)
+	if err != nil {
+		t.Fatalf("GetPage returned error: %v", err)
+	}
+
+	if adapter.SplitN != 2 {
+		t.Errorf("Expected the page to be split after 2 objects, got %d", adapter.SplitN)
+	}
+
+	if got := len(gotResp.GetSuccess().GetObjects()); got != 2 {
+		t.Errorf("Expected 2 objects, got %d: %v", got, gotResp)
+	}
+
+	if got := gotResp.GetSuccess().GetNextCursor(); got != "cursor+2" {
+		t.Errorf("Expected next cursor cursor+2, got %s", got)
+	}
+}
+
+func TestServer_GetPage_InvalidWarnings(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	tests := map[string]struct {
+		warning     framework.Warning
+		wantSuccess bool
+		wantErr     *api_adapter_v1.Error
+	}{
+		"valid": {
+			warning:     framework.NewDeprecatedAPIWarning("The v1 users API is deprecated."),
+			wantSuccess: true,
+		},
+		"unspecified_code": {
+			warning: framework.Warning{
+				Code:    api_adapter_v1.WarningCode_WARNING_CODE_UNSPECIFIED,
+				Message: "Something happened.",
+			},
+			wantErr: &api_adapter_v1.Error{
+				Message: "Adapter returned a warning with an invalid code: 0. This is always indicative of a bug within the Adapter implementation.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"unknown_code": {
+			warning: framework.Warning{
+				Code:    api_adapter_v1.WarningCode(1000),
+				Message: "Something happened.",
+			},
+			wantErr: &api_adapter_v1.Error{
+				Message: "Adapter returned a warning with an invalid code: 1000. This is always indicative of a bug within the Adapter implementation.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+		"empty_message": {
+			warning: framework.Warning{
+				Code: api_adapter_v1.WarningCode_WARNING_CODE_ATTRIBUTE_TRUNCATED,
+			},
+			wantErr: &api_adapter_v1.Error{
+				Message: "Adapter returned a warning with code WARNING_CODE_ATTRIBUTE_TRUNCATED without a message. This is always indicative of a bug within the Adapter implementation.",
+				Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := grpc_metadata.NewIncomingContext(context.Background(), grpc_metadata.MD{
+				"token": validTokens,
+			})
+
+			s := &Server{
+				Tokens:              validTokens,
+				AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+				Logger:              logs.NewMockLogger(),
+			}
+
+			adapter := NewAdapterA(framework.Response{
+				Success: &framework.Page{
+					Objects:  []framework.Object{{"name": "Alice"}},
+					Warnings: []framework.Warning{tc.warning},
+				},
+			})
+
+			if err := RegisterAdapter(s, "Mock-1.0.1", adapter); err != nil {
+				t.Fatal(err)
+			}
+
+			req := &api_adapter_v1.GetPageRequest{
+				Datasource: &api_adapter_v1.DatasourceConfig{
+					Id:   "datasource-789",
+					Type: "Mock-1.0.1",
+				},
+				Entity: &api_adapter_v1.EntityConfig{
+					Id:         "entity-abc",
+					ExternalId: "users",
+					Attributes: []*api_adapter_v1.AttributeConfig{
+						{
+							Id:         "attr-123",
+							ExternalId: "name",
+							Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+						},
+					},
+				},
+				PageSize: 100,
+			}
+
+			gotResp, err := s.GetPage(ctx, req)
+			if err != nil {
+				t.Fatalf("GetPage returned error: %v", err)
+			}
+
+			if tc.wantSuccess {
+				if gotResp.GetSuccess() == nil || len(gotResp.GetSuccess().Warnings) != 1 {
+					t.Errorf("Expected a successful response with 1 warning, got %v", gotResp)
+				}
+
+				return
+			}
+
+			AssertDeepEqual(t, tc.wantErr, gotResp.GetError())
+		})
+	}
+}
+
+type MockSlowAdapter struct {
+	CapturedCtx context.Context
+}
+
+func (a *MockSlowAdapter) GetPage(ctx context.Context, request *framework.Request[TestConfigA]) framework.Response {
+	a.CapturedCtx = ctx
+
+	acc := framework.NewPageAccumulator(ctx, request.PageSize)
+
+	var cursor int
+	for !acc.Done() {
+		time.Sleep(10 * time.Millisecond)
+
+		cursor++
+		acc.Add(framework.Object{"name": fmt.Sprintf("user%d", cursor)})
+	}
+
+	return framework.NewGetPageResponseSuccess(acc.Page(strconv.Itoa(cursor)))
+}
+
+func TestServer_GetPage_PartialPage(t *testing.T) {
+	validTokens := []string{"dGhpc2lzYXRlc3R0b2tlbg=="}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	ctx = grpc_metadata.NewIncomingContext(ctx, grpc_metadata.MD{
+		"token": validTokens,
+	})
+
+	mockAdapter := &MockSlowAdapter{}
+
+	s := &Server{
+		Tokens:              validTokens,
+		AdapterGetPageFuncs: make(map[string]AdapterGetPageFunc),
+		Logger:              logs.NewMockLogger(),
+		SoftDeadlineMargin:  100 * time.Millisecond,
+	}
+
+	if err := RegisterAdapter(s, "Mock-1.0.1", mockAdapter); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &api_adapter_v1.GetPageRequest{
+		Datasource: &api_adapter_v1.DatasourceConfig{
+			Id:   "datasource-789",
+			Type: "Mock-1.0.1",
+		},
+		Entity: &api_adapter_v1.EntityConfig{
+			Id:         "entity-abc",
+			ExternalId: "users",
+			Attributes: []*api_adapter_v1.AttributeConfig{
+				{
+					Id:         "attr-123",
+					ExternalId: "name",
+					Type:       api_adapter_v1.AttributeType_ATTRIBUTE_TYPE_STRING,
+				},
+			},
+		},
+		PageSize: 1000,
+	}
+
+	gotResp, err := s.GetPage(ctx, req)
+	if err != nil {
+		t.Fatalf("GetPage returned error: %v", err)
+	}
+
+	page := gotResp.GetSuccess()
+	if page == nil {
+		t.Fatalf("Expected successful response, got %v", gotResp)
+	}
+
+	if len(page.Objects) == 0 || len(page.Objects) >= 1000 {
+		t.Errorf("Expected a partial page, got %d objects", len(page.Objects))
+	}
+
+	if page.NextCursor != strconv.Itoa(len(page.Objects)) {
+		t.Errorf("Expected next cursor %d, got %s", len(page.Objects), page.NextCursor)
+	}
+
+	entries := logs.FromContext(mockAdapter.CapturedCtx).(*logs.MockLogger).Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d", len(entries))
+	}
+
+	if entries[0].Message != "Adapter returned a partial page before the request deadline" {
+		t.Errorf("Unexpected log message: %s", entries[0].Message)
+	}
+}
@@ -19,24 +19,71 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
 	"github.com/sgnl-ai/adapter-framework/pkg/logs"
+	"github.com/sgnl-ai/adapter-framework/pkg/secrets"
 	"github.com/sgnl-ai/adapter-framework/server/internal"
 )
 
 type Server = internal.Server
 
+// CircuitBreakerConfig configures the circuit breakers that fail GetPage requests
+// fast for datasources that failed repeatedly.
+// Zero fields are set to their defaults: 5 consecutive failures open a circuit
+// for 30 seconds, after which 1 probe request at a time is let through.
+type CircuitBreakerConfig = internal.CircuitBreakerConfig
+
+// AuditSink records an AuditRecord for every authenticated GetPage request.
+// Implementations must be safe for concurrent use.
+type AuditSink = internal.AuditSink
+
+// AuditRecord records which data was returned to which client by a GetPage
+// request: the identity of the auth token, tenant, client, datasource, entity,
+// page size, number of objects returned, outcome and duration.
+// Datasource credentials, configs and cursors are never recorded.
+type AuditRecord = internal.AuditRecord
+
+// FileAuditSink is an AuditSink writing records as JSON Lines into a file
+// rotated by size.
+type FileAuditSink = internal.FileAuditSink
+
+// NewFileAuditSink returns a FileAuditSink appending to the file at the given
+// path. Once writing a record would make the file larger than maxBytes, the file
+// is renamed with a ".1" suffix, previous backups are shifted to ".2", ".3", etc.,
+// and up to maxBackups backups are kept. If maxBytes is not positive, the file
+// is never rotated. Otherwise, maxBackups must be at least 1.
+func NewFileAuditSink(path string, maxBytes int64, maxBackups int) (*FileAuditSink, error) {
+	return internal.NewFileAuditSink(path, maxBytes, maxBackups)
+}
+
 // ServerOption are options for configuring the AdapterServer.
 type ServerOption func(*serverConfig)
 
 // serverConfig holds configuration options for the AdapterServer.
 type serverConfig struct {
-	logger logs.Logger
+	logger             logs.Logger
+	softDeadlineMargin time.Duration
+	responseCache      *internal.ResponseCache
+	prefetcher         *internal.Prefetcher
+	secretResolver     secrets.Resolver
+	resourcePool       *internal.ResourcePool
+	circuitBreaker     *internal.CircuitBreakerConfig
+	auditSink          internal.AuditSink
+	maxPageBytes       int
+	maxSendMsgSize     int
+	gzipCompression    bool
 }
 
+// DefaultMaxSendMsgSize is the maximum size of the messages sent by the gRPC
+// server assumed unless WithMaxSendMsgSize is set, i.e. the default maximum
+// size of the messages received by gRPC clients such as the ingestion service.
+const DefaultMaxSendMsgSize = 4 << 20
+
 // WithLogger configures the server to use the provided logger.
 // The logger must implement the logs.Logger interface.
 func WithLogger(logger logs.Logger) ServerOption {
@@ -45,11 +92,144 @@ func WithLogger(logger logs.Logger) ServerOption {
 	}
 }
 
+// WithSoftDeadlineMargin configures the safety margin between the soft deadline
+// passed to adapters in the context of each GetPage request and the deadline of
+// the RPC.
+// Adapters can use framework.NewPageAccumulator or framework.SoftDeadline to
+// return the objects collected so far, with a resumable NextCursor, before the
+// RPC times out.
+func WithSoftDeadlineMargin(margin time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.softDeadlineMargin = margin
+	}
+}
+
+// WithResponseCache configures the server to cache up to maxEntries GetPage
+// responses for the given TTL, so that GetPage requests retried by the
+// ingestion service are served without calling the adapter again.
+//
+// Requests are identified by their datasource ID, type, address and config,
+// entity config, page size and cursor. A cached response is only returned to
+// a request with the same datasource credentials. Concurrent identical
+// requests result in a single adapter call. Error responses are cached only if
+// they are not transient.
+func WithResponseCache(maxEntries int, ttl time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.responseCache = internal.NewResponseCache(maxEntries, ttl)
+	}
+}
+
+// WithPrefetch configures the server to speculatively get the next page of an
+// entity in the background after returning a page with a next cursor, while
+// the ingestion service processes the returned page.
+//
+// Prefetched pages are buffered until requested, up to a total of maxBytes
+// bytes and for up to the given TTL, which also bounds the duration of each
+// prefetch. At most maxInFlight prefetches run at the same time; no prefetch
+// is started while that limit is reached. Adapters can opt out of
+// prefetching, for all datasources or per datasource config, by implementing
+// framework.PrefetchOptOut.
+func WithPrefetch(maxBytes int64, maxInFlight int, ttl time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.prefetcher = internal.NewPrefetcher(maxBytes, maxInFlight, ttl)
+	}
+}
+
+// WithSecretResolver configures the server to resolve the secret references in
+// datasource configs using the given resolver, before the configs are parsed
+// into the adapters' Config types.
+//
+// A secret reference is a JSON object such as {"$secret": "file:/path"} or
+// {"$secret": "env:NAME"}, which may appear anywhere in a datasource config,
+// and is replaced with the string value of the secret.
+// Use secrets.NewDefaultResolver to resolve "file" references within a root
+// directory and "env" references with a name prefix.
+func WithSecretResolver(resolver secrets.Resolver) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.secretResolver = resolver
+	}
+}
+
+// WithResourceIdleTimeout configures the duration after which the datasource
+// resources created by adapters implementing framework.Lifecycle are closed
+// if they are not used by any GetPage request.
+// Defaults to 10 minutes.
+func WithResourceIdleTimeout(idleTimeout time.Duration) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.resourcePool = internal.NewResourcePool(idleTimeout)
+	}
+}
+
+// WithCircuitBreaker enables circuit breakers for all the adapters, keyed by
+// datasource type and address.
+//
+// A circuit opens after consecutive requests failed with a
+// DATASOURCE_TEMPORARILY_UNAVAILABLE or DATASOURCE_FAILED error or timed out.
+// While open, requests fail fast with a DATASOURCE_TEMPORARILY_UNAVAILABLE error
+// with a RetryAfter, without calling the adapter. Once the open duration has
+// elapsed, probe requests are let through, and the circuit closes as soon as one
+// succeeds.
+//
+// The config may be overridden per adapter with WithAdapterCircuitBreaker.
+func WithCircuitBreaker(config CircuitBreakerConfig) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.circuitBreaker = &config
+	}
+}
+
+// WithAuditSink configures the server to record an AuditRecord into the given
+// sink for every authenticated GetPage request.
+// The token identity recorded is derived from the hash of the auth token of the
+// request. The sink is closed when the server is stopped if it implements
+// io.Closer.
+func WithAuditSink(sink AuditSink) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.auditSink = sink
+	}
+}
+
+// WithMaxPageBytes configures the maximum serialized size of GetPage
+// responses, which must be at most the maximum message size sent by the gRPC
+// server, i.e. DefaultMaxSendMsgSize unless set with WithMaxSendMsgSize. New
+// panics otherwise.
+//
+// If an adapter returns a larger page, the page is split after the objects
+// that fit if the adapter implements framework.PageSplitter. Otherwise, the
+// request fails with an ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG error with the
+// PAGE_TOO_LARGE reason, and with a suggested page size unless a single object
+// exceeds the maximum size.
+func WithMaxPageBytes(maxBytes int) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.maxPageBytes = maxBytes
+	}
+}
+
+// WithMaxSendMsgSize configures the maximum size of the messages sent by the
+// gRPC server, which must be the size set with grpc.MaxSendMsgSize when
+// creating the grpc.Server. The maximum serialized size of GetPage responses
+// set with WithMaxPageBytes must not exceed it, and defaults to it.
+func WithMaxSendMsgSize(maxBytes int) ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.maxSendMsgSize = maxBytes
+	}
+}
+
+// WithGzipCompression configures the server to compress GetPage responses with
+// gzip for the clients supporting it, even if their requests aren't
+// compressed. The gzip compressor is registered with gRPC when this package is
+// imported.
+func WithGzipCompression() ServerOption {
+	return func(cfg *serverConfig) {
+		cfg.gzipCompression = true
+	}
+}
+
 // New returns an AdapterServer that wraps the given high-level
 // Adapter implementation with the Tokens field populated from the file
 // which name is configured in the AUTH_TOKENS_PATH environment variable.
 // The stop channel is used to signal when the file watcher should
-// be closed and stop watching for file changes.
+// be closed and stop watching for file changes, and when the background
+// work of the server, such as prefetches, should be canceled.
 func New(
 	stop <-chan struct{},
 	opts ...ServerOption,
@@ -64,22 +244,126 @@ func New(
 		opt(cfg)
 	}
 
-	return newWithAuthTokensPath(authTokensPath, stop, cfg.logger)
+	if err := cfg.validate(); err != nil {
+		panic(err.Error())
+	}
+
+	server := newWithAuthTokensPath(authTokensPath, stop, cfg.logger)
+	cfg.apply(server.(*internal.Server))
+
+	return server
+}
+
+// validate returns an error if the options are inconsistent.
+func (cfg *serverConfig) validate() error {
+	maxSendMsgSize := cfg.maxSendMsgSize
+	if maxSendMsgSize <= 0 {
+		maxSendMsgSize = DefaultMaxSendMsgSize
+	}
+
+	if cfg.maxPageBytes > maxSendMsgSize {
+		return fmt.Errorf("maximum page size of %d bytes exceeds the maximum message size of %d bytes sent by the gRPC server",
+			cfg.maxPageBytes, maxSendMsgSize)
+	}
+
+	return nil
+}
+
+// apply sets the options that are not required to create the server on the
+// given server.
+func (cfg *serverConfig) apply(s *internal.Server) {
+	s.SoftDeadlineMargin = cfg.softDeadlineMargin
+	s.ResponseCache = cfg.responseCache
+	s.Prefetcher = cfg.prefetcher
+	s.SecretResolver = cfg.secretResolver
+	s.ResourcePool = cfg.resourcePool
+	s.CircuitBreaker = cfg.circuitBreaker
+	s.AuditSink = cfg.auditSink
+	s.MaxPageBytes = cfg.maxPageBytes
+	if s.MaxPageBytes <= 0 && cfg.maxSendMsgSize > 0 {
+		s.MaxPageBytes = cfg.maxSendMsgSize
+	}
+	s.GzipCompression = cfg.gzipCompression
+}
+
+// AdapterOption are options for configuring how requests are handled for a
+// registered Adapter.
+type AdapterOption = internal.AdapterOption
+
+// WithStrictConfig configures the server to reject datasource configs
+// containing fields that are unknown in the adapter's Config type, with an
+// ERROR_CODE_INVALID_DATASOURCE_CONFIG error naming the unknown fields.
+// By default, unknown fields are ignored.
+func WithStrictConfig() AdapterOption {
+	return func(opts *internal.AdapterOptions) {
+		opts.StrictConfig = true
+	}
+}
+
+// WithConfigMigrations configures the server to upgrade datasource configs to the
+// latest version before parsing them, using the given ordered migrations: the
+// migration at index i upgrades configs from version i to version i+1.
+// The version of a config is the value of its top-level "version" field, or 0 if
+// not set.
+// The ValidateConfig RPC returns configs migrated to the latest version, so that
+// stored configs can be upgraded.
+func WithConfigMigrations(migrations ...config.Migration) AdapterOption {
+	return func(opts *internal.AdapterOptions) {
+		opts.ConfigMigrations = migrations
+	}
+}
+
+// WithAdapterCircuitBreaker enables a circuit breaker for the adapter with the
+// given config, overriding the config set with WithCircuitBreaker, if any.
+func WithAdapterCircuitBreaker(config CircuitBreakerConfig) AdapterOption {
+	return func(opts *internal.AdapterOptions) {
+		opts.CircuitBreaker = &config
+	}
 }
 
 // RegisterAdapter registers a new high-level Adapter implementation with the server.
 // The Config type parameter is the type of the config object that will be passed to
 // the high-level Adapter implementation.
 //
+// Datasource configs are validated against the struct tags of the Config type
+// and defaulted before being passed to the adapter. See package
+// github.com/sgnl-ai/adapter-framework/pkg/config for the supported tags.
+//
+// If this function is called with the datasource type of an already-registered Adapter,
+// it will return an error.
+func RegisterAdapter[Config any](
+	s api_adapter_v1.AdapterServer,
+	datasourceType string,
+	adapter framework.Adapter[Config],
+	opts ...AdapterOption,
+) error {
+	internalServer, ok := s.(*internal.Server)
+	if !ok {
+		return errors.New("type assertion to *internal.Server failed")
+	}
+
+	return internal.RegisterAdapter(internalServer, datasourceType, adapter, opts...)
+}
+
+// RegisterProtoAdapter registers a new ProtoAdapter implementation with the server.
+// ProtoAdapters write the objects of pages directly as RPC objects, which avoids
+// converting Objects for high-volume adapters. Otherwise, they are handled like
+// high-level Adapters registered with RegisterAdapter.
+//
 // If this function is called with the datasource type of an already-registered Adapter,
 // it will return an error.
-func RegisterAdapter[Config any](s api_adapter_v1.AdapterServer, datasourceType string, adapter framework.Adapter[Config]) error {
+func RegisterProtoAdapter[Config any](
+	s api_adapter_v1.AdapterServer,
+	datasourceType string,
+	adapter framework.ProtoAdapter[Config],
+	opts ...AdapterOption,
+) error {
 	internalServer, ok := s.(*internal.Server)
 	if !ok {
 		return errors.New("type assertion to *internal.Server failed")
 	}
 
-	return internal.RegisterAdapter(internalServer, datasourceType, adapter)
+	return internal.RegisterProtoAdapter(internalServer, datasourceType, adapter, opts...)
 }
 
 func newWithAuthTokensPath(
@@ -126,6 +410,7 @@ func newWithAuthTokensPath(
 				panic(fmt.Sprintf("file watcher error: %v", err))
 			case <-stop:
 				watcher.Close()
+				s.Close()
 
 				return
 			}
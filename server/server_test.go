@@ -228,3 +228,140 @@ func TestNew_WithLogger(t *testing.T) {
 		t.Error("Expected logger to be set")
 	}
 }
+
+func TestNew_WithSoftDeadlineMargin(t *testing.T) {
+	validTokensPath := "./TOKENS_WITH_SOFT_DEADLINE"
+
+	tokens := []byte(`["dGhpc2lzYXRlc3R0b2tlbg=="]`)
+	if err := os.WriteFile(validTokensPath, tokens, 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(validTokensPath)
+
+	t.Setenv("AUTH_TOKENS_PATH", validTokensPath)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	server := New(stop, WithSoftDeadlineMargin(5*time.Second))
+
+	internalServer, ok := server.(*internal.Server)
+	if !ok {
+		t.Fatal("Expected *internal.Server")
+	}
+
+	AssertDeepEqual(t, 5*time.Second, internalServer.SoftDeadlineMargin)
+}
+
+func TestNew_WithResponseCache(t *testing.T) {
+	validTokensPath := "./TOKENS_WITH_RESPONSE_CACHE"
+
+	tokens := []byte(`["dGhpc2lzYXRlc3R0b2tlbg=="]`)
+	if err := os.WriteFile(validTokensPath, tokens, 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(validTokensPath)
+
+	t.Setenv("AUTH_TOKENS_PATH", validTokensPath)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	server := New(stop, WithResponseCache(100, 30*time.Second))
+
+	internalServer, ok := server.(*internal.Server)
+	if !ok {
+		t.Fatal("Expected *internal.Server")
+	}
+
+	if internalServer.ResponseCache == nil {
+		t.Error("Expected response cache to be set")
+	}
+}
+
+func TestNew_WithMaxPageBytesAndGzipCompression(t *testing.T) {
+	validTokensPath := "./TOKENS_WITH_MAX_PAGE_BYTES"
+
+	tokens := []byte(`["dGhpc2lzYXRlc3R0b2tlbg=="]`)
+	if err := os.WriteFile(validTokensPath, tokens, 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(validTokensPath)
+
+	t.Setenv("AUTH_TOKENS_PATH", validTokensPath)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	server := New(stop, WithMaxPageBytes(4<<20), WithGzipCompression())
+
+	internalServer, ok := server.(*internal.Server)
+	if !ok {
+		t.Fatal("Expected *internal.Server")
+	}
+
+	AssertDeepEqual(t, 4<<20, internalServer.MaxPageBytes)
+	AssertDeepEqual(t, true, internalServer.GzipCompression)
+}
+
+func TestNew_MaxPageBytes(t *testing.T) {
+	validTokensPath := "./TOKENS_MAX_PAGE_BYTES"
+
+	tokens := []byte(`["dGhpc2lzYXRlc3R0b2tlbg=="]`)
+	if err := os.WriteFile(validTokensPath, tokens, 0666); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(validTokensPath)
+
+	t.Setenv("AUTH_TOKENS_PATH", validTokensPath)
+
+	tests := map[string]struct {
+		opts             []ServerOption
+		wantMaxPageBytes int
+		wantPanic        string
+	}{
+		"unset": {},
+		"default_send_limit": {
+			opts:             []ServerOption{WithMaxPageBytes(DefaultMaxSendMsgSize)},
+			wantMaxPageBytes: DefaultMaxSendMsgSize,
+		},
+		"above_default_send_limit": {
+			opts:      []ServerOption{WithMaxPageBytes(DefaultMaxSendMsgSize + 1)},
+			wantPanic: "maximum page size of 4194305 bytes exceeds the maximum message size of 4194304 bytes sent by the gRPC server",
+		},
+		"within_configured_send_limit": {
+			opts:             []ServerOption{WithMaxPageBytes(8 << 20), WithMaxSendMsgSize(16 << 20)},
+			wantMaxPageBytes: 8 << 20,
+		},
+		"above_configured_send_limit": {
+			opts:      []ServerOption{WithMaxPageBytes(2 << 20), WithMaxSendMsgSize(1 << 20)},
+			wantPanic: "maximum page size of 2097152 bytes exceeds the maximum message size of 1048576 bytes sent by the gRPC server",
+		},
+		"defaults_to_send_limit": {
+			opts:             []ServerOption{WithMaxSendMsgSize(1 << 20)},
+			wantMaxPageBytes: 1 << 20,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			stop := make(chan struct{})
+			defer close(stop)
+
+			defer func() {
+				r := recover()
+				if tc.wantPanic == "" && r != nil {
+					t.Fatalf("Unexpected panic: %v", r)
+				}
+
+				if tc.wantPanic != "" {
+					AssertDeepEqual(t, tc.wantPanic, r)
+				}
+			}()
+
+			server := New(stop, tc.opts...)
+
+			AssertDeepEqual(t, tc.wantMaxPageBytes, server.(*internal.Server).MaxPageBytes)
+		})
+	}
+}
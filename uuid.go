@@ -0,0 +1,108 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// UUID is the value of an attribute of type UUID, in RFC 9562 byte order.
+type UUID [16]byte
+
+// String returns the canonical form of the UUID, e.g.
+// "123e4567-e89b-12d3-a456-426614174000".
+func (u UUID) String() string {
+	var buf [36]byte
+
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+
+	return string(buf[:])
+}
+
+// ParseUUID parses a UUID in its canonical form, e.g.
+// "123e4567-e89b-12d3-a456-426614174000", optionally enclosed in braces or
+// prefixed with "urn:uuid:", or as 32 hexadecimal digits without hyphens.
+// Hexadecimal digits may be upper- or lower-case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+
+	trimmed := strings.TrimPrefix(strings.ToLower(s), "urn:uuid:")
+	if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
+		trimmed = trimmed[1 : len(trimmed)-1]
+	}
+
+	switch len(trimmed) {
+	case 36:
+		if trimmed[8] != '-' || trimmed[13] != '-' || trimmed[18] != '-' || trimmed[23] != '-' {
+			return u, fmt.Errorf("invalid UUID: %s", s)
+		}
+
+		trimmed = strings.ReplaceAll(trimmed, "-", "")
+		if len(trimmed) != 32 {
+			return u, fmt.Errorf("invalid UUID: %s", s)
+		}
+	case 32:
+	default:
+		return u, fmt.Errorf("invalid UUID: %s", s)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(trimmed)); err != nil {
+		return u, fmt.Errorf("invalid UUID: %s", s)
+	}
+
+	return u, nil
+}
+
+// UUIDFromMicrosoftGUID returns the UUID encoded in the given 16 bytes in the
+// byte order used by Microsoft for GUIDs, e.g. in the Active Directory
+// objectGUID attribute, where the first three fields are little-endian.
+func UUIDFromMicrosoftGUID(b []byte) (UUID, error) {
+	var u UUID
+
+	if len(b) != len(u) {
+		return u, fmt.Errorf("invalid GUID length: %d bytes", len(b))
+	}
+
+	binary.BigEndian.PutUint32(u[0:4], binary.LittleEndian.Uint32(b[0:4]))
+	binary.BigEndian.PutUint16(u[4:6], binary.LittleEndian.Uint16(b[4:6]))
+	binary.BigEndian.PutUint16(u[6:8], binary.LittleEndian.Uint16(b[6:8]))
+	copy(u[8:], b[8:])
+
+	return u, nil
+}
+
+// MicrosoftGUID returns the UUID encoded in the byte order used by Microsoft
+// for GUIDs, the reverse of UUIDFromMicrosoftGUID.
+func (u UUID) MicrosoftGUID() []byte {
+	b := make([]byte, len(u))
+
+	binary.LittleEndian.PutUint32(b[0:4], binary.BigEndian.Uint32(u[0:4]))
+	binary.LittleEndian.PutUint16(b[4:6], binary.BigEndian.Uint16(u[4:6]))
+	binary.LittleEndian.PutUint16(b[6:8], binary.BigEndian.Uint16(u[6:8]))
+	copy(b[8:], u[8:])
+
+	return b
+}
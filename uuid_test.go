@@ -0,0 +1,97 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	want := UUID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+
+	tests := map[string]struct {
+		s       string
+		wantErr bool
+	}{
+		"canonical": {
+			s: "123e4567-e89b-12d3-a456-426614174000",
+		},
+		"upper_case": {
+			s: "123E4567-E89B-12D3-A456-426614174000",
+		},
+		"braces": {
+			s: "{123e4567-e89b-12d3-a456-426614174000}",
+		},
+		"urn": {
+			s: "urn:uuid:123e4567-e89b-12d3-a456-426614174000",
+		},
+		"no_hyphens": {
+			s: "123e4567e89b12d3a456426614174000",
+		},
+		"misplaced_hyphens": {
+			s:       "123e456-7e89b-12d3-a456-426614174000",
+			wantErr: true,
+		},
+		"invalid_digit": {
+			s:       "123e4567-e89b-12d3-a456-42661417400g",
+			wantErr: true,
+		},
+		"too_short": {
+			s:       "123e4567",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseUUID(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %t, got %v", tt.wantErr, err)
+			}
+
+			if !tt.wantErr && got != want {
+				t.Errorf("Expected %s, got %s", want, got)
+			}
+		})
+	}
+
+	if got := want.String(); got != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Errorf("Expected the canonical form, got %s", got)
+	}
+}
+
+func TestUUIDFromMicrosoftGUID(t *testing.T) {
+	// objectGUID of an Active Directory object with GUID
+	// 01234567-89ab-cdef-0123-456789abcdef.
+	guid := []byte{0x67, 0x45, 0x23, 0x01, 0xab, 0x89, 0xef, 0xcd, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+
+	got, err := UUIDFromMicrosoftGUID(guid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.String() != "01234567-89ab-cdef-0123-456789abcdef" {
+		t.Errorf("Expected 01234567-89ab-cdef-0123-456789abcdef, got %s", got)
+	}
+
+	if !bytes.Equal(guid, got.MicrosoftGUID()) {
+		t.Errorf("Expected %x, got %x", guid, got.MicrosoftGUID())
+	}
+
+	if _, err := UUIDFromMicrosoftGUID(guid[:15]); err == nil {
+		t.Error("Expected an error for a 15-byte GUID")
+	}
+}
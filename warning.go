@@ -0,0 +1,86 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+// Warning is a non-fatal issue encountered while getting a page, returned
+// together with the page instead of failing the whole request.
+type Warning struct {
+	// Code is the warning code indicating the cause of the warning.
+	Code api_adapter_v1.WarningCode `json:"code"`
+
+	// Message is the warning message.
+	// By convention, should start with an upper-case letter.
+	Message string `json:"message,omitempty"`
+
+	// ObjectID is the unique ID of the object involved in the warning, i.e. the
+	// value of the entity's unique ID attribute.
+	// Optional.
+	ObjectID string `json:"objectId,omitempty"`
+
+	// AttributeExternalID is the external ID of the attribute involved in the
+	// warning.
+	// Optional.
+	AttributeExternalID string `json:"attributeExternalId,omitempty"`
+}
+
+// NewObjectSkippedWarning returns a warning for an object with the given unique
+// ID that was skipped because it could not be converted, with the given error.
+// The object ID may be empty if unknown.
+func NewObjectSkippedWarning(objectID string, err error) Warning {
+	return Warning{
+		Code:     api_adapter_v1.WarningCode_WARNING_CODE_OBJECT_SKIPPED,
+		Message:  fmt.Sprintf("Skipped malformed object: %v.", err),
+		ObjectID: objectID,
+	}
+}
+
+// NewAttributeSkippedWarning returns a warning for the value of an attribute of
+// the object with the given unique ID, that was skipped because it could not be
+// converted, with the given error.
+func NewAttributeSkippedWarning(objectID, attributeExternalID string, err error) Warning {
+	return Warning{
+		Code:                api_adapter_v1.WarningCode_WARNING_CODE_ATTRIBUTE_SKIPPED,
+		Message:             fmt.Sprintf("Skipped malformed attribute value: %v.", err),
+		ObjectID:            objectID,
+		AttributeExternalID: attributeExternalID,
+	}
+}
+
+// NewAttributeTruncatedWarning returns a warning for a multi-valued attribute
+// of the object with the given unique ID, of which only the given number of
+// values out of total were returned.
+func NewAttributeTruncatedWarning(objectID, attributeExternalID string, returned, total int) Warning {
+	return Warning{
+		Code:                api_adapter_v1.WarningCode_WARNING_CODE_ATTRIBUTE_TRUNCATED,
+		Message:             fmt.Sprintf("Truncated attribute values: returned %d out of %d.", returned, total),
+		ObjectID:            objectID,
+		AttributeExternalID: attributeExternalID,
+	}
+}
+
+// NewDeprecatedAPIWarning returns a warning indicating that the datasource API
+// used by the adapter is deprecated, with the given message.
+func NewDeprecatedAPIWarning(message string) Warning {
+	return Warning{
+		Code:    api_adapter_v1.WarningCode_WARNING_CODE_DEPRECATED_API,
+		Message: message,
+	}
+}
@@ -35,3 +35,7 @@ func MustParseTime(t *testing.T, v string) time.Time {
 	}
 	return ts
 }
+
+func Ptr[T any](v T) *T {
+	return &v
+}
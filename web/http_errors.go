@@ -18,20 +18,58 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	framework "github.com/sgnl-ai/adapter-framework"
 	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
 )
 
+// requestIDHeaders are the HTTP response headers commonly used by datasources to
+// return the ID of a request, in order of precedence.
+var requestIDHeaders = []string{
+	"X-Request-Id",
+	"X-Amzn-RequestId",
+	"X-Ms-Request-Id",
+	"Request-Id",
+	"X-Correlation-Id",
+}
+
+// HTTPResponseError returns a detailed error if the status code of the given
+// HTTP response indicates that the HTTP request failed, and nil otherwise.
+// In addition to the details returned by HTTPError, the error contains the ID
+// of the request returned by the datasource in a response header, if any.
+func HTTPResponseError(resp *http.Response) *framework.Error {
+	adapterErr := HTTPError(resp.StatusCode, resp.Header.Get("Retry-After"))
+	if adapterErr == nil {
+		return nil
+	}
+
+	for _, header := range requestIDHeaders {
+		if requestID := resp.Header.Get(header); requestID != "" {
+			adapterErr.UpstreamRequestID = requestID
+
+			break
+		}
+	}
+
+	return adapterErr
+}
+
 // HTTPError returns a detailed error if the given HTTP response status code
 // indicates that the HTTP request failed, and nil otherwise.
+// The error's UpstreamStatusCode is the given status code, its Reason is derived
+// from the status code, e.g. "HTTP_TOO_MANY_REQUESTS", and it is Transient if
+// the request may succeed if retried later.
 func HTTPError(statusCode int, retryAfterHeader string) (adapterErr *framework.Error) {
 	if statusCode >= 200 && statusCode < 300 { // Success.
 		return nil
 	}
 
-	adapterErr = new(framework.Error)
+	adapterErr = &framework.Error{
+		UpstreamStatusCode: statusCode,
+		Reason:             httpErrorReason(statusCode),
+	}
 
 	if retryAfterHeader != "" {
 		// Cf. https://datatracker.ietf.org/doc/html/rfc7231#section-7.1.3,
@@ -61,8 +99,16 @@ func HTTPError(statusCode int, retryAfterHeader string) (adapterErr *framework.E
 			adapterErr.Message = "Failed to authenticate with datasource. Check datasource configuration details and try again."
 			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED
 		case http.StatusForbidden:
-			adapterErr.Message = "Access forbidden by datasource. Check datasource configuration details and try again."
-			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED
+			// The credentials were authenticated, but are not granted access
+			// to the requested objects.
+			adapterErr.Message = "Access forbidden by datasource. Check the permissions granted to the datasource credentials and try again."
+			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS
+		case http.StatusNotFound:
+			adapterErr.Message = "Entity not found in datasource. Check entity configuration details and try again."
+			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND
+		case http.StatusGone:
+			adapterErr.Message = "Cursor is no longer valid. Restart the entity from the first page."
+			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_CURSOR_EXPIRED
 		case http.StatusTooManyRequests:
 			adapterErr.Message = "Datasource received too many requests. Adjust datasource sync frequency and try again."
 			adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS
@@ -91,5 +137,27 @@ func HTTPError(statusCode int, retryAfterHeader string) (adapterErr *framework.E
 		adapterErr.Code = api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL
 	}
 
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		adapterErr.Transient = true
+	default:
+		adapterErr.Transient = adapterErr.RetryAfter != nil
+	}
+
 	return
 }
+
+// httpErrorReason returns the machine-readable reason of an error for the given
+// HTTP response status code, e.g. "HTTP_TOO_MANY_REQUESTS" for 429.
+func httpErrorReason(statusCode int) string {
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return fmt.Sprintf("HTTP_STATUS_%d", statusCode)
+	}
+
+	words := strings.FieldsFunc(strings.ToUpper(text), func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	})
+
+	return "HTTP_" + strings.Join(words, "_")
+}
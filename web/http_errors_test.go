@@ -0,0 +1,127 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+)
+
+func TestHTTPResponseError(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		header     http.Header
+		wantErr    *framework.Error
+	}{
+		"success": {
+			statusCode: http.StatusOK,
+			header:     http.Header{"X-Request-Id": {"req-123"}},
+			wantErr:    nil,
+		},
+		"unauthorized": {
+			statusCode: http.StatusUnauthorized,
+			header:     http.Header{"X-Request-Id": {"req-123"}},
+			wantErr: &framework.Error{
+				Message:            "Failed to authenticate with datasource. Check datasource configuration details and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				UpstreamStatusCode: http.StatusUnauthorized,
+				UpstreamRequestID:  "req-123",
+				Reason:             "HTTP_UNAUTHORIZED",
+			},
+		},
+		"forbidden": {
+			statusCode: http.StatusForbidden,
+			header:     http.Header{},
+			wantErr: &framework.Error{
+				Message:            "Access forbidden by datasource. Check the permissions granted to the datasource credentials and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PARTIAL_PERMISSIONS,
+				UpstreamStatusCode: http.StatusForbidden,
+				Reason:             "HTTP_FORBIDDEN",
+			},
+		},
+		"not_found": {
+			statusCode: http.StatusNotFound,
+			header:     http.Header{},
+			wantErr: &framework.Error{
+				Message:            "Entity not found in datasource. Check entity configuration details and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_ENTITY_NOT_FOUND,
+				UpstreamStatusCode: http.StatusNotFound,
+				Reason:             "HTTP_NOT_FOUND",
+			},
+		},
+		"gone": {
+			statusCode: http.StatusGone,
+			header:     http.Header{},
+			wantErr: &framework.Error{
+				Message:            "Cursor is no longer valid. Restart the entity from the first page.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_CURSOR_EXPIRED,
+				UpstreamStatusCode: http.StatusGone,
+				Reason:             "HTTP_GONE",
+			},
+		},
+		"too_many_requests": {
+			statusCode: http.StatusTooManyRequests,
+			header: http.Header{
+				"Retry-After":      {"30"},
+				"X-Amzn-Requestid": {"amzn-456"},
+			},
+			wantErr: &framework.Error{
+				Message:            "Datasource received too many requests. Adjust datasource sync frequency and try again.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TOO_MANY_REQUESTS,
+				RetryAfter:         Ptr(30 * time.Second),
+				UpstreamStatusCode: http.StatusTooManyRequests,
+				UpstreamRequestID:  "amzn-456",
+				Reason:             "HTTP_TOO_MANY_REQUESTS",
+				Transient:          true,
+			},
+		},
+		"service_unavailable": {
+			statusCode: http.StatusServiceUnavailable,
+			header:     http.Header{},
+			wantErr: &framework.Error{
+				Message:            "Datasource is temporarily unavailable; try again later, returned status code: 503.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_TEMPORARILY_UNAVAILABLE,
+				UpstreamStatusCode: http.StatusServiceUnavailable,
+				Reason:             "HTTP_SERVICE_UNAVAILABLE",
+				Transient:          true,
+			},
+		},
+		"unknown_status_code": {
+			statusCode: 599,
+			header:     http.Header{},
+			wantErr: &framework.Error{
+				Message:            "Datasource is permanently unavailable. Contact datasource support for assistance.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_PERMANENTLY_UNAVAILABLE,
+				UpstreamStatusCode: 599,
+				Reason:             "HTTP_STATUS_599",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := HTTPResponseError(&http.Response{
+				StatusCode: tt.statusCode,
+				Header:     tt.header,
+			})
+
+			AssertDeepEqual(t, tt.wantErr, gotErr)
+		})
+	}
+}
@@ -31,12 +31,45 @@ func ConvertJSONObjectList(entity *framework.EntityConfig, objects []map[string]
 	for _, opt := range opts {
 		opt.apply(options)
 	}
-	return convertJSONObjectList(entity, objects, options)
+	return convertJSONObjectList(entity, objects, options, nil)
+}
+
+// ConvertJSONObjectListWithWarnings parses and converts a list of JSON objects
+// received from the given requested entity, like ConvertJSONObjectList, except
+// that the objects which cannot be converted are skipped, and a
+// WARNING_CODE_OBJECT_SKIPPED warning is returned for each of them instead of
+// an error.
+// An error is still returned if the entity config is invalid.
+func ConvertJSONObjectListWithWarnings(
+	entity *framework.EntityConfig,
+	objects []map[string]any,
+	opts ...JSONOption,
+) ([]framework.Object, []framework.Warning, error) {
+	options := defaultJSONOptions()
+	for _, opt := range opts {
+		opt.apply(options)
+	}
+
+	var warnings []framework.Warning
+
+	parsedObjects, err := convertJSONObjectList(entity, objects, options, &warnings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parsedObjects, warnings, nil
 }
 
 // convertJSONObjectList parses and converts a list of JSON objects received
 // from the given requested entity.
-func convertJSONObjectList(entity *framework.EntityConfig, objects []map[string]any, opts *jsonOptions) ([]framework.Object, error) {
+// If warnings is not nil, the objects which cannot be converted are skipped and
+// a warning is appended for each of them, instead of returning an error.
+func convertJSONObjectList(
+	entity *framework.EntityConfig,
+	objects []map[string]any,
+	opts *jsonOptions,
+	warnings *[]framework.Warning,
+) ([]framework.Object, error) {
 	if len(objects) == 0 {
 		return nil, nil
 	}
@@ -61,7 +94,13 @@ func convertJSONObjectList(entity *framework.EntityConfig, objects []map[string]
 		parsedObject, err := convertJSONObject(entity, object, opts, jsonPaths)
 
 		if err != nil {
-			return nil, err
+			if warnings == nil {
+				return nil, err
+			}
+
+			*warnings = append(*warnings, framework.NewObjectSkippedWarning(getJSONObjectUniqueID(entity, object), err))
+
+			continue
 		}
 
 		if len(parsedObject) == 0 {
@@ -74,6 +113,29 @@ func convertJSONObjectList(entity *framework.EntityConfig, objects []map[string]
 	return parsedObjects, nil
 }
 
+// getJSONObjectUniqueID returns the value of the unique ID attribute of the
+// given entity in the given JSON object, or an empty string if not found.
+// Only unique ID attributes which external ID is a top-level field name are
+// supported.
+func getJSONObjectUniqueID(entity *framework.EntityConfig, object map[string]any) string {
+	for _, attribute := range entity.Attributes {
+		if !attribute.UniqueId {
+			continue
+		}
+
+		switch v := object[attribute.ExternalId].(type) {
+		case nil:
+			return ""
+		case string:
+			return v
+		default:
+			return fmt.Sprint(v)
+		}
+	}
+
+	return ""
+}
+
 // parseJSONPaths parses all JSONPaths from attribute external IDs starting
 // with '$' into the given map.
 func parseJSONPaths(entity *framework.EntityConfig, out map[string]gval.Evaluable) error {
@@ -340,7 +402,7 @@ func convertJSONObject(entity *framework.EntityConfig, object map[string]any, op
 				childObjects = append(childObjects, childObject)
 			}
 
-			parsedChildObjects, err = convertJSONObjectList(childEntity, childObjects, opts)
+			parsedChildObjects, err = convertJSONObjectList(childEntity, childObjects, opts, nil)
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse objects for child entity %s: %w", externalId, err)
 			}
@@ -401,7 +463,7 @@ func convertJSONObject(entity *framework.EntityConfig, object map[string]any, op
 				}
 
 				var err error
-				parsedChildObjects, err = convertJSONObjectList(childEntity, childObjects, opts)
+				parsedChildObjects, err = convertJSONObjectList(childEntity, childObjects, opts, nil)
 				if err != nil {
 					return nil, fmt.Errorf("failed to parse objects for child entity %s: %w", externalId, err)
 				}
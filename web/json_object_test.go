@@ -1311,3 +1311,67 @@ func TestConvertJSONObject_JSONPath(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertJSONObjectListWithWarnings(t *testing.T) {
+	entity := &framework.EntityConfig{
+		ExternalId: "users",
+		Attributes: []*framework.AttributeConfig{
+			{
+				ExternalId: "id",
+				Type:       framework.AttributeTypeString,
+				UniqueId:   true,
+			},
+			{
+				ExternalId: "active",
+				Type:       framework.AttributeTypeBool,
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		objectsJSON  string
+		wantObjects  []framework.Object
+		wantWarnings []framework.Warning
+	}{
+		"all_valid": {
+			objectsJSON: `[{"id": "alice", "active": true}, {"id": "bob", "active": false}]`,
+			wantObjects: []framework.Object{
+				{"id": "alice", "active": true},
+				{"id": "bob", "active": false},
+			},
+		},
+		"malformed_object_skipped": {
+			objectsJSON: `[{"id": "alice", "active": true}, {"id": "bob", "active": "maybe"}]`,
+			wantObjects: []framework.Object{
+				{"id": "alice", "active": true},
+			},
+			wantWarnings: []framework.Warning{
+				framework.NewObjectSkippedWarning("bob", errors.New("attribute active cannot be parsed into a bool value")),
+			},
+		},
+		"malformed_object_without_unique_id": {
+			objectsJSON: `[{"active": 12}]`,
+			wantObjects: []framework.Object{},
+			wantWarnings: []framework.Warning{
+				framework.NewObjectSkippedWarning("", errors.New("attribute active cannot be parsed into a bool value")),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var objects []map[string]any
+			if err := json.Unmarshal([]byte(tt.objectsJSON), &objects); err != nil {
+				t.Fatal(err)
+			}
+
+			gotObjects, gotWarnings, err := ConvertJSONObjectListWithWarnings(entity, objects)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			AssertDeepEqual(t, tt.wantObjects, gotObjects)
+			AssertDeepEqual(t, tt.wantWarnings, gotWarnings)
+		})
+	}
+}
@@ -19,6 +19,10 @@ import "time"
 // jsonOptions configures JSON object parsing. The fields are set by the
 // JSONOption values passed to ConvertJSONObjectList.
 type jsonOptions struct {
+	// bytesEncoding is the encoding of the string values of attributes of type
+	// bytes.
+	bytesEncoding BytesEncoding
+
 	// complexAttributeNameDelimiter is the delimiter to use to separate
 	// hierarchical attribute names in attribute external IDs.
 	// That feature is disabled if "".
@@ -28,6 +32,10 @@ type jsonOptions struct {
 	// parsing the values of attributes of type datetime.
 	dateTimeFormats []DateTimeFormatWithTimeZone
 
+	// durationFormats is the set of duration formats that are supported for
+	// parsing the values of attributes of type duration.
+	durationFormats []DurationFormat
+
 	// enableJSONPath indicates whether JSONPath is supported as a syntax for
 	// attribute external IDs. If true, any external ID starting with '$' is
 	// considered to be a JSONPath.
@@ -54,6 +62,46 @@ type DateTimeFormatWithTimeZone struct {
 	HasTimeZone bool
 }
 
+// BytesEncoding is the encoding of the string values of attributes of type
+// bytes in JSON objects.
+type BytesEncoding int
+
+const (
+	// BytesEncodingBase64 is the standard or URL-safe base64 encoding, with or
+	// without padding.
+	BytesEncodingBase64 BytesEncoding = iota
+
+	// BytesEncodingHex is the hexadecimal encoding, with upper- or lower-case
+	// digits.
+	BytesEncodingHex
+)
+
+// DurationFormat is a format of the values of attributes of type duration in
+// JSON objects.
+type DurationFormat int
+
+const (
+	// DurationFormatISO8601 is the ISO8601 duration format, e.g. "P1DT2H" or
+	// "-PT1.5S", parsed with framework.ParseISO8601Duration.
+	DurationFormatISO8601 DurationFormat = iota
+
+	// DurationFormatGo is the Go duration format, e.g. "1h30m" or "-1.5s",
+	// parsed with framework.ParseGoDuration.
+	DurationFormatGo
+
+	// DurationFormatTimeSpan is the .NET TimeSpan format, e.g. "1.02:30:00" or
+	// "-00:00:01.5", parsed with framework.ParseTimeSpan.
+	DurationFormatTimeSpan
+
+	// DurationFormatSeconds is a number of seconds, as a JSON number or
+	// string, e.g. 90 or "1.5".
+	DurationFormatSeconds
+
+	// DurationFormatMilliseconds is a number of milliseconds, as a JSON number
+	// or string, e.g. 90000 or "1500".
+	DurationFormatMilliseconds
+)
+
 const (
 	SGNLUnixMilli       = "SGNLUnixMilli"
 	SGNLUnixSec         = "SGNLUnixSec"
@@ -62,6 +110,7 @@ const (
 
 func defaultJSONOptions() *jsonOptions {
 	return &jsonOptions{
+		bytesEncoding:                 BytesEncodingBase64,
 		complexAttributeNameDelimiter: "", // Disabled.
 		dateTimeFormats: []DateTimeFormatWithTimeZone{
 			{time.RFC3339, true},
@@ -84,6 +133,7 @@ func defaultJSONOptions() *jsonOptions {
 			{SGNLUnixSec, false},        // Unix timestamp representing seconds since 1970-01-01 00:00:00 UTC.
 			{SGNLGeneralizedTime, true}, // https://datatracker.ietf.org/doc/html/rfc4517#section-3.3.13  Generalized Time
 		},
+		durationFormats:     []DurationFormat{DurationFormatISO8601},
 		enableJSONPath:      false, // Disabled.
 		localTimeZoneOffset: 0,
 	}
@@ -102,6 +152,16 @@ func (o *funcJSONOption) apply(opts *jsonOptions) {
 	o.f(opts)
 }
 
+// WithBytesEncoding sets the encoding of the string values of attributes of
+// type bytes. Defaults to BytesEncodingBase64.
+func WithBytesEncoding(encoding BytesEncoding) JSONOption {
+	return &funcJSONOption{
+		f: func(jo *jsonOptions) {
+			jo.bytesEncoding = encoding
+		},
+	}
+}
+
 // WithComplexAttributeNameDelimiter sets the delimiter between nested objects
 // names in attribute external IDs.
 //
@@ -147,6 +207,20 @@ func WithDateTimeFormats(formats ...DateTimeFormatWithTimeZone) JSONOption {
 	}
 }
 
+// WithDurationFormats sets the formats to use to try parsing duration attribute
+// values from strings and numbers.
+// The formats must be ordered by decreasing likelihood of matching.
+// Defaults to DurationFormatISO8601 only.
+// Note: Adding both DurationFormatSeconds and DurationFormatMilliseconds to
+// formats is not recommended, since any number is parsed by the first one.
+func WithDurationFormats(formats ...DurationFormat) JSONOption {
+	return &funcJSONOption{
+		f: func(jo *jsonOptions) {
+			jo.durationFormats = formats
+		},
+	}
+}
+
 // WithJSONPathAttributeNames enables attribute external IDs specified as
 // JSONPath to match attributes in nested objects.
 //
@@ -15,6 +15,8 @@
 package web
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -46,6 +48,10 @@ func convertJSONAttributeValue(attribute *framework.AttributeConfig, value any,
 			return convertJSONAttributeListValue[int64](attribute, value, opts)
 		case framework.AttributeTypeString:
 			return convertJSONAttributeListValue[string](attribute, value, opts)
+		case framework.AttributeTypeBytes:
+			return convertJSONAttributeListValue[[]byte](attribute, value, opts)
+		case framework.AttributeTypeUUID:
+			return convertJSONAttributeListValue[framework.UUID](attribute, value, opts)
 		default:
 			panic("invalid attribute type")
 		}
@@ -119,9 +125,9 @@ func convertJSONAttributeValue(attribute *framework.AttributeConfig, value any,
 		return v, nil
 
 	case framework.AttributeTypeDuration:
-		switch v := value.(type) {
-		case string:
-			duration, err := framework.ParseISO8601Duration(v)
+		switch value.(type) {
+		case string, float64:
+			duration, err := ParseDuration(opts.durationFormats, value)
 			if err != nil {
 				return nil, fmt.Errorf("attribute %s cannot be parsed into a duration value: %w", attribute.ExternalId, err)
 			}
@@ -145,6 +151,31 @@ func convertJSONAttributeValue(attribute *framework.AttributeConfig, value any,
 		}
 		return v, nil
 
+	case framework.AttributeTypeBytes:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("attribute %s cannot be parsed into a bytes value", attribute.ExternalId)
+		}
+		b, err := decodeBytes(opts.bytesEncoding, v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s cannot be parsed into a bytes value: %w", attribute.ExternalId, err)
+		}
+		return b, nil
+
+	case framework.AttributeTypeUUID:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("attribute %s cannot be parsed into a UUID value", attribute.ExternalId)
+		}
+		if v == "" {
+			return nil, nil
+		}
+		u, err := framework.ParseUUID(v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s cannot be parsed into a UUID value: %w", attribute.ExternalId, err)
+		}
+		return u, nil
+
 	default:
 		panic("invalid attribute type")
 	}
@@ -183,6 +214,78 @@ func convertJSONAttributeListValue[Element any](attribute *framework.AttributeCo
 	return parsedList, nil
 }
 
+// decodeBytes decodes the given string with the given encoding.
+func decodeBytes(encoding BytesEncoding, s string) ([]byte, error) {
+	switch encoding {
+	case BytesEncodingHex:
+		return hex.DecodeString(s)
+	default:
+		// Accept the standard and URL-safe alphabets, with or without padding.
+		s = strings.TrimRight(s, "=")
+		if strings.ContainsAny(s, "-_") {
+			return base64.RawURLEncoding.DecodeString(s)
+		}
+		return base64.RawStdEncoding.DecodeString(s)
+	}
+}
+
+// ParseDuration parses a duration string or number against a set of formats.
+// The value must be a string, or a float64 for DurationFormatSeconds and
+// DurationFormatMilliseconds.
+func ParseDuration(durationFormats []DurationFormat, value any) (duration *framework.Duration, err error) {
+	for _, format := range durationFormats {
+		switch v := value.(type) {
+		case string:
+			switch format {
+			case DurationFormatISO8601:
+				duration, err = framework.ParseISO8601Duration(v)
+			case DurationFormatGo:
+				duration, err = framework.ParseGoDuration(v)
+			case DurationFormatTimeSpan:
+				duration, err = framework.ParseTimeSpan(v)
+			case DurationFormatSeconds, DurationFormatMilliseconds:
+				var number float64
+				number, err = strconv.ParseFloat(v, 64)
+				if err == nil {
+					duration, err = parseDurationNumber(format, number)
+				}
+			default:
+				continue
+			}
+		case float64:
+			duration, err = parseDurationNumber(format, v)
+		default:
+			return nil, fmt.Errorf("failed to parse the duration value of type %T", value)
+		}
+
+		if err == nil {
+			return duration, nil
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		err = fmt.Errorf("failed to parse the duration string: %s", v)
+	default:
+		err = fmt.Errorf("failed to parse the duration number: %v", v)
+	}
+
+	return nil, err
+}
+
+// parseDurationNumber converts a number into a duration using the given format.
+// Returns an error if the format doesn't support numbers.
+func parseDurationNumber(format DurationFormat, number float64) (*framework.Duration, error) {
+	switch format {
+	case DurationFormatSeconds:
+		return framework.DurationFromSeconds(number)
+	case DurationFormatMilliseconds:
+		return framework.DurationFromMilliseconds(number)
+	default:
+		return nil, fmt.Errorf("duration format %d doesn't support numbers", format)
+	}
+}
+
 // ParseDateTime parses a timestamp against a set of predefined formats.
 func ParseDateTime(dateTimeFormats []DateTimeFormatWithTimeZone, localTimeZoneOffset int, dateTimeStr string) (dateTime time.Time, err error) {
 	for _, format := range dateTimeFormats {
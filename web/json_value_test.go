@@ -457,6 +457,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P6M5DT4S"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 4,
@@ -471,6 +472,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				List:       true,
 			},
 			valueJSON: `["P6M5DT4S","P1M15DT54S"]`,
+			opts:      defaultJSONOptions(),
 			wantValue: []*framework.Duration{
 				{
 					Nanos:   0,
@@ -492,6 +494,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P2Y"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 0,
@@ -505,6 +508,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P2W"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 0,
@@ -518,6 +522,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"PT2H"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 7200, // 2 hours = 7200
@@ -531,6 +536,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"PT2M"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 120, // 2 minutes = 120
@@ -544,6 +550,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"PT2H10M"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 7800, // 2 hours + 10 minutes = 7200 + 600 = 7800
@@ -557,6 +564,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P1.5M"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 0,
@@ -570,6 +578,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P1.5D"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   0,
 				Seconds: 43200, // 0.5 days = 12 hours = 43200 seconds
@@ -583,6 +592,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"PT1.5S"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{
 				Nanos:   500_000_000,
 				Seconds: 1,
@@ -596,6 +606,7 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"P0M4DT0H0M5S"`,
+			opts:      defaultJSONOptions(),
 			wantValue: &framework.Duration{Nanos: 0, Seconds: 5, Days: 4, Months: 0},
 		},
 		"duration_iso8601_invalid": {
@@ -604,8 +615,68 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 				Type:       framework.AttributeTypeDuration,
 			},
 			valueJSON: `"10s"`,
+			opts:      defaultJSONOptions(),
 			wantError: errors.New("attribute a cannot be parsed into a duration value: failed to parse the duration string: 10s"),
 		},
+		"duration_iso8601_negative": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeDuration,
+			},
+			valueJSON: `"-P1DT1.5S"`,
+			opts:      defaultJSONOptions(),
+			wantValue: &framework.Duration{Nanos: -500_000_000, Seconds: -1, Days: -1},
+		},
+		"duration_number_iso8601_only": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeDuration,
+			},
+			valueJSON: `90`,
+			opts:      defaultJSONOptions(),
+			wantError: errors.New("attribute a cannot be parsed into a duration value: failed to parse the duration number: 90"),
+		},
+		"duration_multiple_formats": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeDuration,
+				List:       true,
+			},
+			valueJSON: `["PT1M", "1h30m", "1.02:03:04.5", 1.5, "2"]`,
+			opts: &jsonOptions{
+				durationFormats: []DurationFormat{
+					DurationFormatISO8601,
+					DurationFormatGo,
+					DurationFormatTimeSpan,
+					DurationFormatSeconds,
+				},
+			},
+			wantValue: []*framework.Duration{
+				{Seconds: 60},
+				{Seconds: 5400},
+				{Days: 1, Seconds: 7384, Nanos: 500_000_000},
+				{Seconds: 1, Nanos: 500_000_000},
+				{Seconds: 2},
+			},
+		},
+		"duration_milliseconds": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeDuration,
+			},
+			valueJSON: `-1500`,
+			opts:      &jsonOptions{durationFormats: []DurationFormat{DurationFormatMilliseconds}},
+			wantValue: &framework.Duration{Seconds: -1, Nanos: -500_000_000},
+		},
+		"duration_no_format_matched": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeDuration,
+			},
+			valueJSON: `"1 hour"`,
+			opts:      &jsonOptions{durationFormats: []DurationFormat{DurationFormatGo, DurationFormatTimeSpan}},
+			wantError: errors.New("attribute a cannot be parsed into a duration value: failed to parse the duration string: 1 hour"),
+		},
 		"int64": {
 			attribute: &framework.AttributeConfig{
 				ExternalId: "a",
@@ -640,6 +711,70 @@ func TestConvertJSONAttributeValue(t *testing.T) {
 			valueJSON: `["a", "b", "c"]`,
 			wantValue: []string{"a", "b", "c"},
 		},
+		"bytes_base64": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeBytes,
+			},
+			valueJSON: `"aGVsbG8="`,
+			opts:      defaultJSONOptions(),
+			wantValue: []byte("hello"),
+		},
+		"bytes_base64_url_unpadded": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeBytes,
+			},
+			valueJSON: `"-_8"`,
+			opts:      defaultJSONOptions(),
+			wantValue: []byte{0xfb, 0xff},
+		},
+		"bytes_hex": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeBytes,
+			},
+			valueJSON: `"CAFE"`,
+			opts:      &jsonOptions{bytesEncoding: BytesEncodingHex},
+			wantValue: []byte{0xca, 0xfe},
+		},
+		"bytes_invalid_hex": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeBytes,
+			},
+			valueJSON: `"xyz"`,
+			opts:      &jsonOptions{bytesEncoding: BytesEncodingHex},
+			wantError: errors.New("attribute a cannot be parsed into a bytes value: encoding/hex: invalid byte: U+0078 'x'"),
+		},
+		"bytes_list": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeBytes,
+				List:       true,
+			},
+			valueJSON: `["aGVsbG8=", "d29ybGQ="]`,
+			opts:      defaultJSONOptions(),
+			wantValue: [][]byte{[]byte("hello"), []byte("world")},
+		},
+		"uuid": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeUUID,
+			},
+			valueJSON: `"{123E4567-E89B-12D3-A456-426614174000}"`,
+			opts:      defaultJSONOptions(),
+			wantValue: framework.UUID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00},
+		},
+		"uuid_invalid": {
+			attribute: &framework.AttributeConfig{
+				ExternalId: "a",
+				Type:       framework.AttributeTypeUUID,
+			},
+			valueJSON: `"not-a-uuid"`,
+			opts:      defaultJSONOptions(),
+			wantError: errors.New("attribute a cannot be parsed into a UUID value: invalid UUID: not-a-uuid"),
+		},
 	}
 
 	for name, tc := range tests {
@@ -0,0 +1,717 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/web"
+)
+
+// Adapter is a generic Adapter for REST datasources, which requests are
+// specified by the Spec in the config of each datasource.
+//
+// Register it with server.RegisterAdapter, e.g.:
+//
+//	server.RegisterAdapter(s, "REST-1.0.0", rest.NewAdapter(client))
+type Adapter struct {
+	// Client is the HTTP client used to send requests to datasources.
+	Client *http.Client
+}
+
+// NewAdapter returns an Adapter sending requests with the given HTTP client,
+// e.g. a client created with client.NewSGNLHTTPClientWithProxy to support
+// datasources accessed through a connector.
+// If client is nil, http.DefaultClient is used.
+func NewAdapter(client *http.Client) *Adapter {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &Adapter{
+		Client: client,
+	}
+}
+
+// GetPage gets a page of objects of the requested entity, as specified by the
+// Spec of the requested datasource.
+func (a *Adapter) GetPage(ctx context.Context, request *framework.Request[Spec]) framework.Response {
+	if request.Config == nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: "Datasource config is missing.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		})
+	}
+
+	spec := request.Config
+
+	compiled, err := spec.compile()
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Datasource config is invalid: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		})
+	}
+
+	entity, found := compiled.entities[request.Entity.ExternalId]
+	if !found {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message:          fmt.Sprintf("Entity %s is not specified in the datasource config.", request.Entity.ExternalId),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			EntityExternalID: request.Entity.ExternalId,
+		})
+	}
+
+	c, adapterErr := a.newClient(request, compiled)
+	if adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	if request.ChildPage != nil {
+		object, adapterErr := c.getChildPageObject(ctx, entity, &request.Entity, request.ChildPage, request.PageSize,
+			spec.jsonOptions())
+		if adapterErr != nil {
+			return framework.NewGetPageResponseError(adapterErr)
+		}
+
+		return framework.NewGetPageResponseSuccess(&framework.Page{
+			Objects: []framework.Object{object},
+		})
+	}
+
+	data := &templateData{
+		PageSize: request.PageSize,
+	}
+
+	objects, nextCursor, adapterErr := c.getPage(ctx, entity, data, request.Cursor)
+	if adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	childCursors, adapterErr := c.getChildPages(ctx, entity, &request.Entity, objects, request.PageSize)
+	if adapterErr != nil {
+		return framework.NewGetPageResponseError(adapterErr)
+	}
+
+	convertedObjects, err := web.ConvertJSONObjectList(&request.Entity, objects, spec.jsonOptions()...)
+	if err != nil {
+		return framework.NewGetPageResponseError(&framework.Error{
+			Message: fmt.Sprintf("Failed to convert the objects returned by the datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		})
+	}
+
+	for i, cursors := range childCursors {
+		for childEntityExternalID, cursor := range cursors {
+			if err := framework.SetChildObjectsCursor(convertedObjects[i], childEntityExternalID, cursor); err != nil {
+				return framework.NewGetPageResponseError(&framework.Error{
+					Message: fmt.Sprintf("Failed to set the cursor of the child objects of entity %s: %v.",
+						childEntityExternalID, err),
+					Code: api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				})
+			}
+		}
+	}
+
+	return framework.NewGetPageResponseSuccess(&framework.Page{
+		Objects:    convertedObjects,
+		NextCursor: nextCursor,
+	})
+}
+
+// jsonOptions returns the options to convert JSON objects with.
+func (s *Spec) jsonOptions() []web.JSONOption {
+	opts := []web.JSONOption{
+		web.WithLocalTimeZoneOffset(s.LocalTimeZoneOffset),
+	}
+
+	if s.ComplexAttributeNameDelimiter != "" {
+		opts = append(opts, web.WithComplexAttributeNameDelimiter(s.ComplexAttributeNameDelimiter))
+	}
+
+	if s.JSONPathAttributeNames {
+		opts = append(opts, web.WithJSONPathAttributeNames())
+	}
+
+	return opts
+}
+
+// client sends the requests of a GetPage request to a datasource.
+type client struct {
+	httpClient *http.Client
+
+	// baseURL is the URL the paths of the entities are relative to.
+	baseURL *url.URL
+
+	// headers are the headers sent in every request.
+	headers map[string]*template.Template
+
+	// auth specifies how credentials are sent.
+	auth *AuthSpec
+
+	// credentials are the credentials sent in every request, if not empty.
+	credentials string
+}
+
+// newClient returns a client sending the requests for the given GetPage
+// request, as specified by the given compiled spec.
+func (a *Adapter) newClient(request *framework.Request[Spec], compiled *compiledSpec) (*client, *framework.Error) {
+	spec := request.Config
+
+	rawBaseURL := spec.BaseURL
+	if rawBaseURL == "" {
+		rawBaseURL = request.Address
+
+		if !strings.Contains(rawBaseURL, "://") {
+			rawBaseURL = "https://" + rawBaseURL
+		}
+	}
+
+	baseURL, err := url.Parse(rawBaseURL)
+	if err != nil || baseURL.Host == "" {
+		return nil, &framework.Error{
+			Message: "Datasource base URL is invalid. Check the datasource address and config.",
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+		}
+	}
+
+	var credentials string
+
+	switch {
+	case request.Auth == nil:
+	case request.Auth.Basic != nil:
+		credentials = "Basic " + base64.StdEncoding.EncodeToString(
+			[]byte(request.Auth.Basic.Username+":"+request.Auth.Basic.Password))
+	default:
+		credentials = request.Auth.HTTPAuthorization
+	}
+
+	return &client{
+		httpClient:  a.Client,
+		baseURL:     baseURL,
+		headers:     compiled.headers,
+		auth:        &spec.Auth,
+		credentials: strings.TrimPrefix(credentials, spec.Auth.TrimPrefix),
+	}, nil
+}
+
+// getChildPages gets the first page of objects of each requested child entity
+// of the given entity which has a spec, for each of the given JSON objects, and
+// sets them into the JSON objects. The following pages are returned for
+// ChildPageRequests, since the child collections of some objects can be
+// arbitrarily large.
+//
+// Returns the cursors of the next pages of child objects of each JSON object,
+// by child entity external ID.
+// Once the soft deadline of ctx has passed, no more child objects are
+// requested, and the cursors of the first pages of child objects are returned
+// for the remaining JSON objects instead.
+func (c *client) getChildPages(
+	ctx context.Context,
+	entity *compiledEntity,
+	entityConfig *framework.EntityConfig,
+	objects []map[string]any,
+	pageSize int64,
+) ([]map[string]string, *framework.Error) {
+	cursors := make([]map[string]string, len(objects))
+
+	for i, object := range objects {
+		childObjects := make(map[string][]any)
+
+		for _, childEntityConfig := range entityConfig.ChildEntities {
+			childEntity, found := entity.childEntities[childEntityConfig.ExternalId]
+			if !found {
+				continue
+			}
+
+			var nextCursor string
+
+			if !framework.SoftDeadlineExceeded(ctx) {
+				var adapterErr *framework.Error
+
+				childObjects[childEntityConfig.ExternalId], nextCursor, adapterErr = c.getChildPage(
+					ctx, childEntity, childEntityConfig, object, "", pageSize)
+				if adapterErr != nil {
+					return nil, adapterErr
+				}
+
+				if nextCursor == "" {
+					continue
+				}
+			}
+
+			// The parent object must be encoded before its child objects are
+			// set into it.
+			cursor, err := encodeChildCursor(object, nextCursor)
+			if err != nil {
+				return nil, &framework.Error{
+					Message:          fmt.Sprintf("Failed to encode the cursor of the child objects of entity %s: %v.", childEntity.spec.ExternalID, err),
+					Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+					EntityExternalID: childEntity.spec.ExternalID,
+				}
+			}
+
+			if cursors[i] == nil {
+				cursors[i] = make(map[string]string)
+			}
+
+			cursors[i][childEntityConfig.ExternalId] = cursor
+		}
+
+		for childEntityExternalID, childObjects := range childObjects {
+			object[childEntityExternalID] = childObjects
+		}
+	}
+
+	return cursors, nil
+}
+
+// getChildPageObject gets the page of objects of a child entity of the given
+// entity requested by the given ChildPageRequest, and returns the parent
+// object containing only its unique ID attributes and the page of child
+// objects.
+func (c *client) getChildPageObject(
+	ctx context.Context,
+	entity *compiledEntity,
+	entityConfig *framework.EntityConfig,
+	childPage *framework.ChildPageRequest,
+	pageSize int64,
+	opts []web.JSONOption,
+) (framework.Object, *framework.Error) {
+	var childEntityConfig *framework.EntityConfig
+
+	for _, config := range entityConfig.ChildEntities {
+		if config.ExternalId == childPage.ChildEntityExternalID {
+			childEntityConfig = config
+		}
+	}
+
+	childEntity, found := entity.childEntities[childPage.ChildEntityExternalID]
+	if !found || childEntityConfig == nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Child entity %s of entity %s is not specified in the datasource config.",
+				childPage.ChildEntityExternalID, entity.spec.ExternalID),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+			EntityExternalID: childPage.ChildEntityExternalID,
+		}
+	}
+
+	parent, cursor, err := decodeChildCursor(childPage.Cursor)
+	if err != nil {
+		return nil, &framework.Error{
+			Message:          fmt.Sprintf("Cursor for child entity %s is invalid.", childEntity.spec.ExternalID),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+			EntityExternalID: childEntity.spec.ExternalID,
+		}
+	}
+
+	childObjects, nextCursor, adapterErr := c.getChildPage(ctx, childEntity, childEntityConfig, parent, cursor, pageSize)
+	if adapterErr != nil {
+		return nil, adapterErr
+	}
+
+	parentEntityConfig := &framework.EntityConfig{
+		ExternalId:    entityConfig.ExternalId,
+		ChildEntities: []*framework.EntityConfig{childEntityConfig},
+	}
+
+	for _, attribute := range entityConfig.Attributes {
+		if attribute.UniqueId {
+			parentEntityConfig.Attributes = append(parentEntityConfig.Attributes, attribute)
+		}
+	}
+
+	parentObject := maps.Clone(parent)
+	parentObject[childEntityConfig.ExternalId] = childObjects
+
+	convertedObjects, err := web.ConvertJSONObjectList(parentEntityConfig, []map[string]any{parentObject}, opts...)
+	if err != nil {
+		return nil, &framework.Error{
+			Message: fmt.Sprintf("Failed to convert the objects returned by the datasource: %v.", err),
+			Code:    api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+		}
+	}
+
+	object := convertedObjects[0]
+
+	if nextCursor != "" {
+		if nextCursor, err = encodeChildCursor(parent, nextCursor); err == nil {
+			err = framework.SetChildObjectsCursor(object, childEntityConfig.ExternalId, nextCursor)
+		}
+
+		if err != nil {
+			return nil, &framework.Error{
+				Message:          fmt.Sprintf("Failed to set the cursor of the child objects of entity %s: %v.", childEntity.spec.ExternalID, err),
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INTERNAL,
+				EntityExternalID: childEntity.spec.ExternalID,
+			}
+		}
+	}
+
+	return object, nil
+}
+
+// getChildPage gets the page identified by the given cursor of the given child
+// entity for the given parent JSON object, with all the objects of the child
+// entities of its objects, and returns the JSON objects of the page and the
+// cursor of the next page, which is empty if it is the last page.
+func (c *client) getChildPage(
+	ctx context.Context,
+	entity *compiledEntity,
+	entityConfig *framework.EntityConfig,
+	parent map[string]any,
+	cursor string,
+	pageSize int64,
+) ([]any, string, *framework.Error) {
+	data := &templateData{
+		PageSize: pageSize,
+		Parent:   parent,
+	}
+
+	objects, nextCursor, adapterErr := c.getPage(ctx, entity, data, cursor)
+	if adapterErr != nil {
+		return nil, "", adapterErr
+	}
+
+	if adapterErr := c.getChildObjects(ctx, entity, entityConfig, objects, pageSize); adapterErr != nil {
+		return nil, "", adapterErr
+	}
+
+	childObjects := make([]any, 0, len(objects))
+
+	for _, object := range objects {
+		childObjects = append(childObjects, object)
+	}
+
+	return childObjects, nextCursor, nil
+}
+
+// getChildObjects gets all the objects of the requested child entities of the
+// given child entity which have a spec, for each of the given JSON objects,
+// and sets them into the JSON objects, recursively.
+// Only the child objects of the requested entity are paged, since
+// ChildPageRequests only identify direct child entities of the requested
+// entity.
+func (c *client) getChildObjects(
+	ctx context.Context,
+	entity *compiledEntity,
+	entityConfig *framework.EntityConfig,
+	objects []map[string]any,
+	pageSize int64,
+) *framework.Error {
+	for _, childEntityConfig := range entityConfig.ChildEntities {
+		childEntity, found := entity.childEntities[childEntityConfig.ExternalId]
+		if !found {
+			continue
+		}
+
+		for _, object := range objects {
+			childObjects, adapterErr := c.getAllObjects(ctx, childEntity, childEntityConfig, object, pageSize)
+			if adapterErr != nil {
+				return adapterErr
+			}
+
+			object[childEntityConfig.ExternalId] = childObjects
+		}
+	}
+
+	return nil
+}
+
+// getAllObjects gets the objects of all the pages of the given child entity
+// for the given parent JSON object, requested with the given page size.
+func (c *client) getAllObjects(
+	ctx context.Context,
+	entity *compiledEntity,
+	entityConfig *framework.EntityConfig,
+	parent map[string]any,
+	pageSize int64,
+) ([]any, *framework.Error) {
+	var allObjects []any
+
+	for cursor := ""; ; {
+		objects, nextCursor, adapterErr := c.getChildPage(ctx, entity, entityConfig, parent, cursor, pageSize)
+		if adapterErr != nil {
+			return nil, adapterErr
+		}
+
+		allObjects = append(allObjects, objects...)
+
+		if nextCursor == "" {
+			return allObjects, nil
+		}
+
+		cursor = nextCursor
+	}
+}
+
+// getPage gets the page of the given entity identified by the given cursor,
+// and returns the JSON objects of the page and the cursor of the next page,
+// which is empty if it is the last page.
+func (c *client) getPage(
+	ctx context.Context,
+	entity *compiledEntity,
+	data *templateData,
+	cursor string,
+) ([]map[string]any, string, *framework.Error) {
+	pagination := newPaginator(&entity.spec.Pagination, entity.nextPath)
+
+	req, adapterErr := c.newRequest(ctx, entity, data, pagination, cursor)
+	if adapterErr != nil {
+		return nil, "", adapterErr
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", framework.ErrorFromGoError(err)
+	}
+	defer resp.Body.Close()
+
+	if adapterErr := web.HTTPResponseError(resp); adapterErr != nil {
+		adapterErr.Message = fmt.Sprintf("Datasource rejected the request for entity %s, returned status code: %d.",
+			entity.spec.ExternalID, resp.StatusCode)
+		adapterErr.EntityExternalID = entity.spec.ExternalID
+
+		return nil, "", adapterErr
+	}
+
+	var body any
+
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, "", &framework.Error{
+			Message:          fmt.Sprintf("Failed to parse the response for entity %s as JSON.", entity.spec.ExternalID),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+			EntityExternalID: entity.spec.ExternalID,
+		}
+	}
+
+	objects, adapterErr := getJSONObjects(ctx, entity, body)
+	if adapterErr != nil {
+		return nil, "", adapterErr
+	}
+
+	nextCursor := pagination.nextCursor(ctx, req, resp, body, cursor, len(objects), data.PageSize)
+
+	// Never return the credentials in a cursor, since the next page URLs
+	// returned by some datasources contain the query parameters of the request.
+	if pagination.cursorIsURL() && c.auth.In == AuthInQuery {
+		nextCursor = removeQueryParam(nextCursor, c.auth.Name)
+	}
+
+	// Stop instead of requesting the same page again.
+	if nextCursor == cursor {
+		nextCursor = ""
+	}
+
+	return objects, nextCursor, nil
+}
+
+// newRequest returns the HTTP request to get the page of the given entity
+// identified by the given cursor.
+func (c *client) newRequest(
+	ctx context.Context,
+	entity *compiledEntity,
+	data *templateData,
+	pagination *paginator,
+	cursor string,
+) (*http.Request, *framework.Error) {
+	configErr := func(err error) *framework.Error {
+		return &framework.Error{
+			Message:          fmt.Sprintf("Failed to build the request for entity %s: %v.", entity.spec.ExternalID, err),
+			Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			EntityExternalID: entity.spec.ExternalID,
+		}
+	}
+
+	var requestURL *url.URL
+
+	if pagination.cursorIsURL() && cursor != "" {
+		nextURL, err := url.Parse(cursor)
+
+		// Never send the datasource credentials to another host.
+		if err != nil || nextURL.Scheme != c.baseURL.Scheme || nextURL.Host != c.baseURL.Host {
+			return nil, &framework.Error{
+				Message:          fmt.Sprintf("Cursor for entity %s is not a URL of the datasource.", entity.spec.ExternalID),
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: entity.spec.ExternalID,
+			}
+		}
+
+		requestURL = nextURL
+	} else {
+		path, err := executeTemplate(entity.path, data)
+		if err != nil {
+			return nil, configErr(err)
+		}
+
+		relativeURL, err := url.Parse(path)
+		if err != nil {
+			return nil, configErr(err)
+		}
+
+		// Join the escaped path, so that the path segments escaped by the
+		// template, e.g. IDs containing '/', are kept as is. Dot segments are
+		// rejected, since they would otherwise be resolved and could escape the
+		// path of the base URL.
+		escapedPath := relativeURL.EscapedPath()
+		if hasDotSegment(escapedPath) {
+			return nil, &framework.Error{
+				Message:          fmt.Sprintf("Path of the request for entity %s contains a '.' or '..' segment.", entity.spec.ExternalID),
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: entity.spec.ExternalID,
+			}
+		}
+
+		requestURL = c.baseURL.JoinPath(escapedPath)
+		query := relativeURL.Query()
+
+		for key, tmpl := range entity.query {
+			value, err := executeTemplate(tmpl, data)
+			if err != nil {
+				return nil, configErr(err)
+			}
+
+			query.Set(key, value)
+		}
+
+		if err := pagination.setQuery(query, cursor, data.PageSize); err != nil {
+			return nil, &framework.Error{
+				Message:          fmt.Sprintf("Cursor for entity %s is invalid: %v.", entity.spec.ExternalID, err),
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: entity.spec.ExternalID,
+			}
+		}
+
+		requestURL.RawQuery = query.Encode()
+	}
+
+	var body string
+
+	if entity.body != nil {
+		var err error
+		if body, err = executeTemplate(entity.body, data); err != nil {
+			return nil, configErr(err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, entity.spec.Method, requestURL.String(), strings.NewReader(body))
+	if err != nil {
+		return nil, configErr(err)
+	}
+
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	for _, headers := range []map[string]*template.Template{c.headers, entity.headers} {
+		for key, tmpl := range headers {
+			value, err := executeTemplate(tmpl, data)
+			if err != nil {
+				return nil, configErr(err)
+			}
+
+			req.Header.Set(key, value)
+		}
+	}
+
+	if c.credentials != "" {
+		switch c.auth.In {
+		case AuthInQuery:
+			query := req.URL.Query()
+			query.Set(c.auth.Name, c.credentials)
+			req.URL.RawQuery = query.Encode()
+		default:
+			req.Header.Set(c.auth.Name, c.credentials)
+		}
+	}
+
+	return req, nil
+}
+
+// getJSONObjects returns the JSON objects at the objects path of the given
+// entity in the given response body.
+func getJSONObjects(ctx context.Context, entity *compiledEntity, body any) ([]map[string]any, *framework.Error) {
+	value, err := entity.objectsPath(ctx, body)
+	if err != nil || value == nil {
+		// Nothing found at the path.
+		return nil, nil
+	}
+
+	invalidErr := &framework.Error{
+		Message:          fmt.Sprintf("Response for entity %s doesn't contain an array of objects at %s.", entity.spec.ExternalID, entity.spec.ObjectsPath),
+		Code:             api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+		EntityExternalID: entity.spec.ExternalID,
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		return nil, invalidErr
+	}
+
+	objects := make([]map[string]any, 0, len(values))
+
+	for _, value := range values {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, invalidErr
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+// hasDotSegment returns true if the given escaped path contains a '.' or '..'
+// segment, escaped or not.
+func hasDotSegment(escapedPath string) bool {
+	for _, segment := range strings.Split(escapedPath, "/") {
+		if unescaped, err := url.PathUnescape(segment); err == nil && (unescaped == "." || unescaped == "..") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeQueryParam returns the given URL without the given query parameter.
+func removeQueryParam(rawURL, param string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := u.Query()
+	if !query.Has(param) {
+		return rawURL
+	}
+
+	query.Del(param)
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,745 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	framework "github.com/sgnl-ai/adapter-framework"
+	api_adapter_v1 "github.com/sgnl-ai/adapter-framework/api/adapter/v1"
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+// users are the users of the test datasource, which are all in the groups
+// "all" and "user-<id>".
+var users = []map[string]any{
+	{"id": "u1", "name": "Alice"},
+	{"id": "u2", "name": "Bob"},
+	{"id": "u3", "name": "Carol"},
+}
+
+// newTestDatasource returns a test server serving the users with each
+// pagination type.
+func newTestDatasource(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	// getPage returns the users from the given offset, up to the limit query
+	// parameter, and the offset of the next page, or -1 if it is the last page.
+	getPage := func(r *http.Request, offset int) ([]map[string]any, int) {
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = len(users)
+		}
+
+		end := min(offset+limit, len(users))
+		if end == len(users) {
+			return users[offset:end], -1
+		}
+
+		return users[offset:end], end
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/offset", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		page, _ := getPage(r, offset)
+
+		writeJSON(w, map[string]any{"items": page})
+	})
+
+	mux.HandleFunc("GET /api/pages", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		objects, _ := getPage(r, page*2)
+
+		writeJSON(w, objects)
+	})
+
+	mux.HandleFunc("GET /api/cursor", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		page, next := getPage(r, offset)
+
+		meta := map[string]any{}
+		if next >= 0 {
+			meta["next"] = strconv.Itoa(next)
+		}
+
+		writeJSON(w, map[string]any{"data": page, "meta": meta})
+	})
+
+	mux.HandleFunc("GET /api/link", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page, next := getPage(r, offset)
+
+		if next >= 0 {
+			w.Header().Set("Link", fmt.Sprintf(`</api/link?offset=%d&limit=%s>; rel="next", </api/link>; rel="first"`,
+				next, r.URL.Query().Get("limit")))
+		}
+
+		writeJSON(w, page)
+	})
+
+	mux.HandleFunc("GET /api/next", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page, next := getPage(r, offset)
+
+		body := map[string]any{"value": page}
+		if next >= 0 {
+			body["nextLink"] = fmt.Sprintf("/api/next?offset=%d&limit=%s&key=%s",
+				next, r.URL.Query().Get("limit"), r.URL.Query().Get("key"))
+		}
+
+		writeJSON(w, body)
+	})
+
+	mux.HandleFunc("POST /api/search", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Name string `json:"name"`
+		}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		var page []map[string]any
+
+		for _, user := range users {
+			if user["name"] == body.Name {
+				page = append(page, user)
+			}
+		}
+
+		writeJSON(w, map[string]any{"results": page})
+	})
+
+	mux.HandleFunc("GET /api/users/{id}/groups", func(w http.ResponseWriter, r *http.Request) {
+		groups := []map[string]any{{"id": "all"}, {"id": "user-" + r.PathValue("id")}}
+
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?cursor=2>; rel="next"`, r.URL.Path))
+			writeJSON(w, groups[:1])
+
+			return
+		}
+
+		writeJSON(w, groups[1:])
+	})
+
+	mux.HandleFunc("GET /api/groups/{id}/members", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []map[string]any{{"id": "member-of-" + r.PathValue("id")}})
+	})
+
+	mux.HandleFunc("GET /api/secure", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" || r.Header.Get("X-Tenant") != "tenant-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		writeJSON(w, users[:1])
+	})
+
+	mux.HandleFunc("GET /api/invalid", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{"items": "not an array"})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAdapterGetPage(t *testing.T) {
+	server := newTestDatasource(t)
+
+	usersEntity := framework.EntityConfig{
+		ExternalId: "users",
+		Attributes: []*framework.AttributeConfig{
+			{ExternalId: "id", Type: framework.AttributeTypeString, UniqueId: true},
+			{ExternalId: "name", Type: framework.AttributeTypeString},
+		},
+	}
+
+	usersWithGroupsEntity := usersEntity
+	usersWithGroupsEntity.ChildEntities = []*framework.EntityConfig{
+		{
+			ExternalId: "groups",
+			Attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString},
+			},
+		},
+	}
+
+	usersWithMembersEntity := usersEntity
+	usersWithMembersEntity.ChildEntities = []*framework.EntityConfig{
+		{
+			ExternalId: "members",
+			Attributes: []*framework.AttributeConfig{
+				{ExternalId: "id", Type: framework.AttributeTypeString},
+			},
+		},
+	}
+
+	// usersWithMembersSpec gets the members of the group with the ID of each
+	// user, to test the escaping of parent IDs in paths.
+	usersWithMembersSpec := &Spec{
+		BaseURL: server.URL + "/api",
+		Entities: []EntitySpec{
+			{
+				ExternalID: "users",
+				Path:       "/users",
+				ChildEntities: []EntitySpec{
+					{
+						ExternalID: "members",
+						Path:       "/groups/{{pathEscape .Parent.id}}/members",
+					},
+				},
+			},
+		},
+	}
+
+	childCursor := func(parent map[string]any, cursor string) string {
+		encoded, err := encodeChildCursor(parent, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return encoded
+	}
+
+	usersWithGroupsSpec := &Spec{
+		Entities: []EntitySpec{
+			{
+				ExternalID:  "users",
+				Path:        "/api/offset",
+				ObjectsPath: "$.items",
+				Pagination:  PaginationSpec{Type: PaginationOffset, Param: "skip", SizeParam: "limit"},
+				ChildEntities: []EntitySpec{
+					{
+						ExternalID: "groups",
+						Path:       "/api/users/{{pathEscape .Parent.id}}/groups",
+						Pagination: PaginationSpec{Type: PaginationLinkHeader},
+					},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		entity    framework.EntityConfig
+		spec      *Spec
+		auth      *framework.DatasourceAuthCredentials
+		pageSize  int64
+		cursor    string
+		childPage *framework.ChildPageRequest
+
+		// softDeadlinePassed indicates whether the soft deadline of the
+		// request has passed before the request is sent.
+		softDeadlinePassed bool
+
+		wantResp framework.Response
+	}{
+		"offset": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/offset",
+						ObjectsPath: "$.items",
+						Pagination:  PaginationSpec{Type: PaginationOffset, Param: "skip", SizeParam: "limit"},
+					},
+				},
+			},
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice"},
+					{"id": "u2", "name": "Bob"},
+				},
+				NextCursor: "2",
+			}),
+		},
+		"offset_last_page": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/offset",
+						ObjectsPath: "$.items",
+						Pagination:  PaginationSpec{Type: PaginationOffset, Param: "skip", SizeParam: "limit"},
+					},
+				},
+			},
+			pageSize: 2,
+			cursor:   "2",
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u3", "name": "Carol"},
+				},
+			}),
+		},
+		"offset_invalid_cursor": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/offset",
+						ObjectsPath: "$.items",
+						Pagination:  PaginationSpec{Type: PaginationOffset},
+					},
+				},
+			},
+			pageSize: 2,
+			cursor:   "invalid",
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          `Cursor for entity users is invalid: "invalid" is not a non-negative integer.`,
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: "users",
+			}),
+		},
+		"page_number": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/pages",
+						Pagination: PaginationSpec{Type: PaginationPageNumber, SizeParam: "limit", FirstPage: new(int64)},
+					},
+				},
+			},
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice"},
+					{"id": "u2", "name": "Bob"},
+				},
+				NextCursor: "1",
+			}),
+		},
+		"cursor": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/cursor",
+						ObjectsPath: "$.data",
+						Pagination:  PaginationSpec{Type: PaginationCursor, Param: "after", SizeParam: "limit", NextPath: "$.meta.next"},
+					},
+				},
+			},
+			pageSize: 2,
+			cursor:   "1",
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u2", "name": "Bob"},
+					{"id": "u3", "name": "Carol"},
+				},
+			}),
+		},
+		"link_header": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/link",
+						Pagination: PaginationSpec{Type: PaginationLinkHeader, SizeParam: "limit"},
+					},
+				},
+			},
+			pageSize: 1,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice"},
+				},
+				NextCursor: server.URL + "/api/link?offset=1&limit=1",
+			}),
+		},
+		"next_url_without_credentials": {
+			entity: usersEntity,
+			spec: &Spec{
+				Auth: AuthSpec{In: AuthInQuery, Name: "key", TrimPrefix: "Bearer "},
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/next",
+						ObjectsPath: "$.value",
+						Pagination:  PaginationSpec{Type: PaginationNextURL, SizeParam: "limit", NextPath: "$.nextLink"},
+					},
+				},
+			},
+			auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer secret",
+			},
+			pageSize: 1,
+			cursor:   server.URL + "/api/next?offset=1&limit=1",
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u2", "name": "Bob"},
+				},
+				NextCursor: server.URL + "/api/next?limit=1&offset=2",
+			}),
+		},
+		"next_url_on_other_host": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/next",
+						Pagination: PaginationSpec{Type: PaginationNextURL, NextPath: "$.nextLink"},
+					},
+				},
+			},
+			pageSize: 1,
+			cursor:   "https://attacker.example.com/api/next",
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Cursor for entity users is not a URL of the datasource.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: "users",
+			}),
+		},
+		"post_body": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Method:      http.MethodPost,
+						Path:        "/api/search",
+						Body:        `{"name": "Bob", "limit": {{.PageSize}}}`,
+						ObjectsPath: "$.results",
+					},
+				},
+			},
+			pageSize: 10,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u2", "name": "Bob"},
+				},
+			}),
+		},
+		"headers_and_auth": {
+			entity: usersEntity,
+			spec: &Spec{
+				Auth:    AuthSpec{In: AuthInHeader, Name: "X-Api-Key", TrimPrefix: "Bearer "},
+				Headers: map[string]string{"X-Tenant": "tenant-1"},
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/secure",
+					},
+				},
+			},
+			auth: &framework.DatasourceAuthCredentials{
+				HTTPAuthorization: "Bearer secret",
+			},
+			pageSize: 10,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice"},
+				},
+			}),
+		},
+		"unauthorized": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/secure",
+					},
+				},
+			},
+			pageSize: 10,
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:            "Datasource rejected the request for entity users, returned status code: 401.",
+				Code:               api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_AUTHENTICATION_FAILED,
+				UpstreamStatusCode: http.StatusUnauthorized,
+				EntityExternalID:   "users",
+				Reason:             "HTTP_UNAUTHORIZED",
+			}),
+		},
+		"child_entity_sub_requests": {
+			entity:   usersWithGroupsEntity,
+			spec:     usersWithGroupsSpec,
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice", "groups": framework.ChildObjects{
+						Objects:    []framework.Object{{"id": "all"}},
+						NextCursor: childCursor(users[0], server.URL+"/api/users/u1/groups?cursor=2"),
+					}},
+					{"id": "u2", "name": "Bob", "groups": framework.ChildObjects{
+						Objects:    []framework.Object{{"id": "all"}},
+						NextCursor: childCursor(users[1], server.URL+"/api/users/u2/groups?cursor=2"),
+					}},
+				},
+				NextCursor: "2",
+			}),
+		},
+		"child_entity_after_soft_deadline": {
+			entity:             usersWithGroupsEntity,
+			spec:               usersWithGroupsSpec,
+			pageSize:           2,
+			softDeadlinePassed: true,
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "name": "Alice", "groups": framework.ChildObjects{
+						NextCursor: childCursor(users[0], ""),
+					}},
+					{"id": "u2", "name": "Bob", "groups": framework.ChildObjects{
+						NextCursor: childCursor(users[1], ""),
+					}},
+				},
+				NextCursor: "2",
+			}),
+		},
+		"child_page_first": {
+			entity:   usersWithGroupsEntity,
+			spec:     usersWithGroupsSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "groups",
+				ParentID:              "u1",
+				Cursor:                childCursor(users[0], ""),
+			},
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "groups": framework.ChildObjects{
+						Objects:    []framework.Object{{"id": "all"}},
+						NextCursor: childCursor(users[0], server.URL+"/api/users/u1/groups?cursor=2"),
+					}},
+				},
+			}),
+		},
+		"child_page_last": {
+			entity:   usersWithGroupsEntity,
+			spec:     usersWithGroupsSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "groups",
+				ParentID:              "u1",
+				Cursor:                childCursor(users[0], server.URL+"/api/users/u1/groups?cursor=2"),
+			},
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "u1", "groups": []framework.Object{{"id": "user-u1"}}},
+				},
+			}),
+		},
+		"child_page_invalid_cursor": {
+			entity:   usersWithGroupsEntity,
+			spec:     usersWithGroupsSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "groups",
+				ParentID:              "u1",
+				Cursor:                "invalid",
+			},
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Cursor for child entity groups is invalid.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: "groups",
+			}),
+		},
+		"child_page_unknown_child_entity": {
+			entity:   usersWithGroupsEntity,
+			spec:     usersWithGroupsSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "roles",
+				ParentID:              "u1",
+				Cursor:                childCursor(users[0], ""),
+			},
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Child entity roles of entity users is not specified in the datasource config.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				EntityExternalID: "roles",
+			}),
+		},
+		"child_page_parent_id_with_slash": {
+			entity:   usersWithMembersEntity,
+			spec:     usersWithMembersSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "members",
+				ParentID:              "a/b",
+				Cursor:                childCursor(map[string]any{"id": "a/b"}, ""),
+			},
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "a/b", "members": []framework.Object{{"id": "member-of-a/b"}}},
+				},
+			}),
+		},
+		"child_page_parent_id_with_dot_segments": {
+			entity:   usersWithMembersEntity,
+			spec:     usersWithMembersSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "members",
+				ParentID:              "../../admin",
+				Cursor:                childCursor(map[string]any{"id": "../../admin"}, ""),
+			},
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "../../admin", "members": []framework.Object{{"id": "member-of-../../admin"}}},
+				},
+			}),
+		},
+		"child_page_parent_id_dot_segment": {
+			entity:   usersWithMembersEntity,
+			spec:     usersWithMembersSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "members",
+				ParentID:              "..",
+				Cursor:                childCursor(map[string]any{"id": ".."}, ""),
+			},
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Path of the request for entity members contains a '.' or '..' segment.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_PAGE_REQUEST_CONFIG,
+				EntityExternalID: "members",
+			}),
+		},
+		"child_page_parent_id_with_percent": {
+			entity:   usersWithMembersEntity,
+			spec:     usersWithMembersSpec,
+			pageSize: 2,
+			childPage: &framework.ChildPageRequest{
+				ChildEntityExternalID: "members",
+				ParentID:              "50%off",
+				Cursor:                childCursor(map[string]any{"id": "50%off"}, ""),
+			},
+			wantResp: framework.NewGetPageResponseSuccess(&framework.Page{
+				Objects: []framework.Object{
+					{"id": "50%off", "members": []framework.Object{{"id": "member-of-50%off"}}},
+				},
+			}),
+		},
+		"unknown_entity": {
+			entity: framework.EntityConfig{ExternalId: "groups"},
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/offset",
+					},
+				},
+			},
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Entity groups is not specified in the datasource config.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_ENTITY_CONFIG,
+				EntityExternalID: "groups",
+			}),
+		},
+		"invalid_template": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID: "users",
+						Path:       "/api/{{.Parent.id",
+					},
+				},
+			},
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message: `Datasource config is invalid: entities.users.path is an invalid template: ` +
+					`template: users.path:1: unclosed action.`,
+				Code: api_adapter_v1.ErrorCode_ERROR_CODE_INVALID_DATASOURCE_CONFIG,
+			}),
+		},
+		"objects_not_an_array": {
+			entity: usersEntity,
+			spec: &Spec{
+				Entities: []EntitySpec{
+					{
+						ExternalID:  "users",
+						Path:        "/api/invalid",
+						ObjectsPath: "$.items",
+					},
+				},
+			},
+			pageSize: 2,
+			wantResp: framework.NewGetPageResponseError(&framework.Error{
+				Message:          "Response for entity users doesn't contain an array of objects at $.items.",
+				Code:             api_adapter_v1.ErrorCode_ERROR_CODE_DATASOURCE_FAILED,
+				EntityExternalID: "users",
+			}),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := config.ApplyDefaults(tc.spec); err != nil {
+				t.Fatal(err)
+			}
+
+			request := &framework.Request[Spec]{
+				Config:    tc.spec,
+				Address:   server.URL,
+				Auth:      tc.auth,
+				Entity:    tc.entity,
+				PageSize:  tc.pageSize,
+				Cursor:    tc.cursor,
+				ChildPage: tc.childPage,
+			}
+
+			ctx := context.Background()
+
+			if tc.softDeadlinePassed {
+				// The soft deadline is set halfway to the deadline.
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Second)
+				defer cancel()
+
+				ctx = framework.WithSoftDeadline(ctx, time.Second)
+
+				for !framework.SoftDeadlineExceeded(ctx) {
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+
+			gotResp := NewAdapter(server.Client()).GetPage(ctx, request)
+
+			if !reflect.DeepEqual(tc.wantResp, gotResp) {
+				t.Errorf("Expected %#v, got %#v", tc.wantResp, gotResp)
+
+				if gotResp.Error != nil {
+					t.Errorf("Got error %#v", gotResp.Error)
+				}
+			}
+		})
+	}
+}
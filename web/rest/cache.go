@@ -0,0 +1,96 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"container/list"
+	"sync"
+)
+
+// maxCompiledSpecs is the maximum number of compiled specs cached.
+const maxCompiledSpecs = 256
+
+// compiledSpecs caches the compiled specs of the datasources, since the config
+// of a datasource is parsed again for every request.
+var compiledSpecs = newSpecCache(maxCompiledSpecs)
+
+// specCache is a cache of compiled specs keyed by the JSON encoding of their
+// Spec, which evicts the least recently used specs when full.
+type specCache struct {
+	maxEntries int
+
+	// mu must be locked for every access to the fields below.
+	mu sync.Mutex
+
+	// entries maps each key to its element in lru.
+	entries map[string]*list.Element
+
+	// lru contains the cached *specCacheEntry values, the most recently used
+	// first.
+	lru *list.List
+}
+
+// specCacheEntry is a compiled spec cached for a key.
+type specCacheEntry struct {
+	key  string
+	spec *compiledSpec
+}
+
+// newSpecCache returns a specCache that contains at most maxEntries specs.
+func newSpecCache(maxEntries int) *specCache {
+	return &specCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// get returns the compiled spec cached for the given key, or nil if not
+// found.
+func (c *specCache) get(key string) *compiledSpec {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, found := c.entries[key]
+	if !found {
+		return nil
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return elem.Value.(*specCacheEntry).spec
+}
+
+// add caches the given compiled spec for the given key, evicting the least
+// recently used specs if the cache is full.
+func (c *specCache) add(key string, spec *compiledSpec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, found := c.entries[key]; found {
+		c.lru.Remove(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(&specCacheEntry{
+		key:  key,
+		spec: spec,
+	})
+
+	for c.lru.Len() > c.maxEntries {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.entries, elem.Value.(*specCacheEntry).key)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/PaesslerAG/gval"
+)
+
+// paginator sets the pagination query parameters of requests and extracts the
+// cursors of next pages from responses, as specified by a PaginationSpec.
+//
+// The cursor of a page is the URL of the page for PaginationLinkHeader and
+// PaginationNextURL, the offset of its first object for PaginationOffset, its
+// number for PaginationPageNumber, and its cursor token for PaginationCursor.
+type paginator struct {
+	spec     *PaginationSpec
+	nextPath gval.Evaluable
+}
+
+// newPaginator returns a paginator for the given spec, which next path is
+// parsed into nextPath.
+func newPaginator(spec *PaginationSpec, nextPath gval.Evaluable) *paginator {
+	return &paginator{
+		spec:     spec,
+		nextPath: nextPath,
+	}
+}
+
+// cursorIsURL returns true if cursors are the URLs of the pages.
+func (p *paginator) cursorIsURL() bool {
+	return p.spec.Type == PaginationLinkHeader || p.spec.Type == PaginationNextURL
+}
+
+// setQuery sets the pagination query parameters for the page identified by the
+// given cursor into the given query, if cursors are not URLs.
+func (p *paginator) setQuery(query url.Values, cursor string, pageSize int64) error {
+	if p.spec.Type != PaginationNone && p.spec.SizeParam != "" {
+		query.Set(p.spec.SizeParam, strconv.FormatInt(pageSize, 10))
+	}
+
+	switch p.spec.Type {
+	case PaginationOffset:
+		offset := int64(0)
+
+		if cursor != "" {
+			var err error
+			if offset, err = parseNonNegativeInt(cursor); err != nil {
+				return err
+			}
+		}
+
+		query.Set(p.spec.param(), strconv.FormatInt(offset, 10))
+	case PaginationPageNumber:
+		page := p.firstPage()
+
+		if cursor != "" {
+			var err error
+			if page, err = parseNonNegativeInt(cursor); err != nil {
+				return err
+			}
+		}
+
+		query.Set(p.spec.param(), strconv.FormatInt(page, 10))
+	case PaginationCursor:
+		if cursor != "" {
+			query.Set(p.spec.param(), cursor)
+		}
+	}
+
+	return nil
+}
+
+// nextCursor returns the cursor of the page following the page identified by
+// the given cursor, which was returned with the given number of objects in
+// the given response, or an empty string if it was the last page.
+func (p *paginator) nextCursor(
+	ctx context.Context,
+	req *http.Request,
+	resp *http.Response,
+	body any,
+	cursor string,
+	objectCount int,
+	pageSize int64,
+) string {
+	switch p.spec.Type {
+	case PaginationLinkHeader:
+		return resolveURL(req.URL, getNextLink(resp.Header.Values("Link")))
+	case PaginationNextURL:
+		return resolveURL(req.URL, p.getNextValue(ctx, body))
+	case PaginationCursor:
+		return p.getNextValue(ctx, body)
+	case PaginationOffset, PaginationPageNumber:
+		if p.isLastPage(objectCount, pageSize) {
+			return ""
+		}
+
+		// The cursor was validated when the request was created.
+		current, _ := parseNonNegativeInt(cursor)
+
+		if p.spec.Type == PaginationOffset {
+			return strconv.FormatInt(current+int64(objectCount), 10)
+		}
+
+		if cursor == "" {
+			current = p.firstPage()
+		}
+
+		return strconv.FormatInt(current+1, 10)
+	default:
+		return ""
+	}
+}
+
+// isLastPage returns true if a page with the given number of objects is the
+// last page, i.e. if it is empty or, if the page size is sent, if it contains
+// fewer objects than the page size.
+func (p *paginator) isLastPage(objectCount int, pageSize int64) bool {
+	return objectCount == 0 || (p.spec.SizeParam != "" && int64(objectCount) < pageSize)
+}
+
+// firstPage returns the number of the first page.
+func (p *paginator) firstPage() int64 {
+	if p.spec.FirstPage == nil {
+		return 1
+	}
+
+	return *p.spec.FirstPage
+}
+
+// getNextValue returns the string or number at the next path in the given
+// response body, or an empty string if not found.
+func (p *paginator) getNextValue(ctx context.Context, body any) string {
+	value, err := p.nextPath(ctx, body)
+	if err != nil {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// getNextLink returns the URL of the link with the "next" relation type in the
+// given Link header values, as specified by RFC 8288, or an empty string if
+// not found.
+func getNextLink(headerValues []string) string {
+	for _, headerValue := range headerValues {
+		for _, link := range strings.Split(headerValue, ",") {
+			target, params, found := strings.Cut(link, ";")
+			if !found {
+				continue
+			}
+
+			target = strings.TrimSpace(target)
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+
+			for _, param := range strings.Split(params, ";") {
+				name, value, _ := strings.Cut(param, "=")
+				if !strings.EqualFold(strings.TrimSpace(name), "rel") {
+					continue
+				}
+
+				// The relation type may contain multiple space-separated types.
+				for _, relType := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+					if strings.EqualFold(relType, "next") {
+						return target[1 : len(target)-1]
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// resolveURL resolves the given URL reference relative to the given base URL,
+// or returns an empty string if the reference is empty or invalid.
+func resolveURL(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+
+	return base.ResolveReference(refURL).String()
+}
+
+// parseNonNegativeInt parses the given offset or page number cursor.
+func parseNonNegativeInt(cursor string) (int64, error) {
+	value, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%q is not a non-negative integer", cursor)
+	}
+
+	return value, nil
+}
+
+// childCursor is the content of the cursor of a page of objects of a child
+// entity, which contains the parent JSON object, since the requests for the
+// child entity are built from the parent object, which is not requested again.
+type childCursor struct {
+	// Parent is the parent JSON object.
+	Parent map[string]any `json:"parent"`
+
+	// Cursor is the cursor of the page of the child entity, which is empty for
+	// the first page.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// encodeChildCursor returns the cursor of the page identified by the given
+// cursor of a child entity of the given parent JSON object.
+func encodeChildCursor(parent map[string]any, cursor string) (string, error) {
+	data, err := json.Marshal(&childCursor{
+		Parent: parent,
+		Cursor: cursor,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeChildCursor returns the parent JSON object and the cursor of the page
+// of a child entity contained in the given cursor.
+func decodeChildCursor(cursor string) (parent map[string]any, pageCursor string, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var decoded childCursor
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, "", err
+	}
+
+	if decoded.Parent == nil {
+		return nil, "", errors.New("cursor contains no parent object")
+	}
+
+	return decoded.Parent, decoded.Cursor, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+)
+
+func TestGetNextLink(t *testing.T) {
+	tests := map[string]struct {
+		headerValues []string
+		want         string
+	}{
+		"no_header": {
+			headerValues: nil,
+			want:         "",
+		},
+		"next": {
+			headerValues: []string{`<https://example.com/users?page=2>; rel="next"`},
+			want:         "https://example.com/users?page=2",
+		},
+		"multiple_links": {
+			headerValues: []string{`<https://example.com/users?page=1>; rel="prev", <https://example.com/users?page=3>; rel="next"`},
+			want:         "https://example.com/users?page=3",
+		},
+		"multiple_headers": {
+			headerValues: []string{`</users?page=1>; rel="first"`, `</users?page=2>; title="Next"; REL=next`},
+			want:         "/users?page=2",
+		},
+		"multiple_relation_types": {
+			headerValues: []string{`</users?page=2>; rel="next last"`},
+			want:         "/users?page=2",
+		},
+		"no_next": {
+			headerValues: []string{`</users?page=1>; rel="first", </users?page=9>; rel="last"`},
+			want:         "",
+		},
+		"invalid_link": {
+			headerValues: []string{`/users?page=2; rel="next"`},
+			want:         "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := getNextLink(tc.headerValues); got != tc.want {
+				t.Errorf("Expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
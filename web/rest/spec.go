@@ -0,0 +1,440 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rest implements a generic Adapter for REST datasources, which
+// requests and pagination are described declaratively by a Spec.
+//
+// The Spec is the config of the datasources of the Adapter, so that a new REST
+// connector only requires a JSON spec and no Go code:
+//
+//	{
+//	  "auth": {"in": "header", "name": "Authorization"},
+//	  "entities": [
+//	    {
+//	      "externalId": "users",
+//	      "path": "/api/v1/users",
+//	      "objectsPath": "$.data",
+//	      "pagination": {"type": "cursor", "param": "after", "sizeParam": "limit", "nextPath": "$.meta.next"},
+//	      "childEntities": [
+//	        {
+//	          "externalId": "groups",
+//	          "path": "/api/v1/users/{{pathEscape .Parent.id}}/groups",
+//	          "pagination": {"type": "linkHeader"}
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+)
+
+// Pagination types.
+const (
+	// PaginationNone indicates that all the objects are returned in a single
+	// page.
+	PaginationNone = "none"
+
+	// PaginationLinkHeader indicates that the URL of the next page is returned
+	// in a Link response header with rel="next", as specified by RFC 8288.
+	PaginationLinkHeader = "linkHeader"
+
+	// PaginationNextURL indicates that the URL of the next page is returned in
+	// the response body, at the JSONPath PaginationSpec.NextPath.
+	PaginationNextURL = "nextURL"
+
+	// PaginationOffset indicates that the offset of the first object of a page
+	// is sent in the PaginationSpec.Param query parameter.
+	PaginationOffset = "offset"
+
+	// PaginationPageNumber indicates that the number of a page is sent in the
+	// PaginationSpec.Param query parameter.
+	PaginationPageNumber = "pageNumber"
+
+	// PaginationCursor indicates that the cursor token of the next page is
+	// returned in the response body, at the JSONPath PaginationSpec.NextPath,
+	// and sent in the PaginationSpec.Param query parameter.
+	PaginationCursor = "cursor"
+)
+
+// Auth placements.
+const (
+	// AuthInHeader indicates that the datasource credentials are sent in a
+	// request header.
+	AuthInHeader = "header"
+
+	// AuthInQuery indicates that the datasource credentials are sent in a
+	// query parameter.
+	AuthInQuery = "query"
+)
+
+// Spec is the declarative specification of the requests sent to a REST
+// datasource to get the pages of its entities.
+type Spec struct {
+	// BaseURL is the URL the paths of the entities are relative to.
+	// Optional. Defaults to the address of the datasource, with the "https"
+	// scheme if it has none.
+	BaseURL string `json:"baseURL,omitempty" validate:"url" description:"URL the paths of the entities are relative to. Defaults to the datasource address."`
+
+	// Auth specifies how the datasource credentials are sent.
+	Auth AuthSpec `json:"auth,omitempty"`
+
+	// Headers are the headers sent in every request, as templates.
+	// Optional.
+	Headers map[string]string `json:"headers,omitempty" description:"Headers sent in every request, as templates."`
+
+	// Entities are the specs of the entities that can be requested.
+	Entities []EntitySpec `json:"entities" validate:"required" description:"Specs of the entities that can be requested."`
+
+	// ComplexAttributeNameDelimiter is the delimiter of hierarchical attribute
+	// names in attribute external IDs. See web.WithComplexAttributeNameDelimiter.
+	// Optional.
+	ComplexAttributeNameDelimiter string `json:"complexAttributeNameDelimiter,omitempty"`
+
+	// JSONPathAttributeNames indicates whether the attribute external IDs
+	// starting with '$' are JSONPaths. See web.WithJSONPathAttributeNames.
+	// Optional.
+	JSONPathAttributeNames bool `json:"jsonPathAttributeNames,omitempty"`
+
+	// LocalTimeZoneOffset is the offset in seconds east of UTC of date-times
+	// without a time zone. See web.WithLocalTimeZoneOffset.
+	// Optional.
+	LocalTimeZoneOffset int `json:"localTimeZoneOffset,omitempty"`
+
+	// compiled is the compiled spec, set by Validate when the config is
+	// parsed.
+	compiled *compiledSpec
+}
+
+// AuthSpec specifies how the datasource credentials are sent.
+// HTTP authorization credentials are sent as is, and basic credentials as
+// "Basic " followed by the base64-encoded username and password.
+type AuthSpec struct {
+	// In is where the credentials are sent: AuthInHeader or AuthInQuery.
+	In string `json:"in,omitempty" validate:"enum=header|query" default:"header"`
+
+	// Name is the name of the header or query parameter.
+	Name string `json:"name,omitempty" default:"Authorization"`
+
+	// TrimPrefix is a prefix removed from the credentials, e.g. "Bearer " to
+	// send only the token.
+	// Optional.
+	TrimPrefix string `json:"trimPrefix,omitempty"`
+}
+
+// EntitySpec specifies the requests sent to get the objects of an entity.
+//
+// The path, query parameters, headers and body are Go templates executed with
+// the following data:
+//   - .PageSize is the requested page size.
+//   - .Parent is the JSON object of the parent object, for child entities.
+//
+// The "pathEscape" and "queryEscape" functions escape values for paths and
+// query parameters.
+type EntitySpec struct {
+	// ExternalID is the external ID of the entity.
+	ExternalID string `json:"externalId" validate:"required"`
+
+	// Method is the HTTP method of the requests.
+	Method string `json:"method,omitempty" validate:"enum=GET|POST" default:"GET"`
+
+	// Path is the path of the requests, relative to the base URL, as a
+	// template.
+	Path string `json:"path" validate:"required"`
+
+	// Query are the query parameters of the requests, as templates.
+	// Optional.
+	Query map[string]string `json:"query,omitempty"`
+
+	// Headers are the headers of the requests, in addition to the headers of
+	// the spec, as templates.
+	// Optional.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the JSON body of the requests, as a template.
+	// Optional.
+	Body string `json:"body,omitempty"`
+
+	// ObjectsPath is the JSONPath to the array of objects in response bodies.
+	// No objects are returned if nothing is found at the path.
+	ObjectsPath string `json:"objectsPath,omitempty" default:"$"`
+
+	// Pagination specifies how the pages of the entity are requested.
+	Pagination PaginationSpec `json:"pagination,omitempty"`
+
+	// ChildEntities are the specs of the sub-requests sent for each object to
+	// get the objects of its child entities.
+	// Only the first page of objects of each child entity is returned in each
+	// object, with a cursor to request the following pages in ChildPageRequests.
+	// The objects of requested child entities without a spec are expected to
+	// be in the JSON objects of the entity.
+	// Optional.
+	ChildEntities []EntitySpec `json:"childEntities,omitempty"`
+}
+
+// PaginationSpec specifies how the pages of an entity are requested.
+type PaginationSpec struct {
+	// Type is the pagination type, which is one of the Pagination constants.
+	Type string `json:"type,omitempty" validate:"enum=none|linkHeader|nextURL|offset|pageNumber|cursor" default:"none"`
+
+	// Param is the query parameter of the offset, page number or cursor token.
+	// Defaults to "offset", "page" or "cursor" respectively.
+	Param string `json:"param,omitempty"`
+
+	// SizeParam is the query parameter of the page size.
+	// Optional. If not set, the page size is not sent.
+	SizeParam string `json:"sizeParam,omitempty"`
+
+	// FirstPage is the number of the first page for PaginationPageNumber.
+	FirstPage *int64 `json:"firstPage,omitempty" default:"1"`
+
+	// NextPath is the JSONPath to the URL of the next page for
+	// PaginationNextURL, or to the cursor token of the next page for
+	// PaginationCursor, in response bodies.
+	// There is no next page if nothing is found at the path.
+	NextPath string `json:"nextPath,omitempty"`
+}
+
+// param returns the query parameter of the offset, page number or cursor.
+func (p *PaginationSpec) param() string {
+	if p.Param != "" {
+		return p.Param
+	}
+
+	switch p.Type {
+	case PaginationOffset:
+		return "offset"
+	case PaginationPageNumber:
+		return "page"
+	default:
+		return "cursor"
+	}
+}
+
+// compiledEntity is an EntitySpec with parsed templates and JSONPaths.
+type compiledEntity struct {
+	spec *EntitySpec
+
+	path        *template.Template
+	query       map[string]*template.Template
+	headers     map[string]*template.Template
+	body        *template.Template
+	objectsPath gval.Evaluable
+	nextPath    gval.Evaluable
+
+	// childEntities maps the external ID of each child entity with a spec to
+	// its compiled spec.
+	childEntities map[string]*compiledEntity
+}
+
+// templateFuncs are the functions available in templates.
+var templateFuncs = template.FuncMap{
+	"pathEscape":  url.PathEscape,
+	"queryEscape": url.QueryEscape,
+}
+
+// compiledSpec is a Spec with parsed templates and JSONPaths.
+type compiledSpec struct {
+	// headers are the headers sent in every request.
+	headers map[string]*template.Template
+
+	// entities maps the external ID of each entity to its compiled spec.
+	entities map[string]*compiledEntity
+}
+
+// Validate parses the templates and JSONPaths of the spec, so that invalid
+// specs are rejected when configs are parsed, e.g. by the ValidateConfig RPC,
+// rather than when pages are requested.
+// The parsed spec is kept in the spec to serve requests.
+func (s *Spec) Validate() error {
+	compiled, err := s.compile()
+	if err != nil {
+		return err
+	}
+
+	s.compiled = compiled
+
+	return nil
+}
+
+// compile returns the compiled spec, which is parsed only if it was not
+// parsed when validated, nor cached for an identical spec.
+func (s *Spec) compile() (*compiledSpec, error) {
+	if s.compiled != nil {
+		return s.compiled, nil
+	}
+
+	key, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if compiled := compiledSpecs.get(string(key)); compiled != nil {
+		return compiled, nil
+	}
+
+	compiled, err := compileSpec(s)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledSpecs.add(string(key), compiled)
+
+	return compiled, nil
+}
+
+// compileSpec parses the templates and JSONPaths of the given spec.
+func compileSpec(spec *Spec) (*compiledSpec, error) {
+	headers, err := parseTemplates("headers", spec.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := &compiledSpec{
+		headers:  headers,
+		entities: make(map[string]*compiledEntity, len(spec.Entities)),
+	}
+
+	for i := range spec.Entities {
+		entity, err := compileEntity(&spec.Entities[i])
+		if err != nil {
+			return nil, fmt.Errorf("entities.%w", err)
+		}
+
+		compiled.entities[spec.Entities[i].ExternalID] = entity
+	}
+
+	return compiled, nil
+}
+
+// compileEntity parses the templates and JSONPaths of the given entity spec
+// and of its child entity specs, recursively.
+func compileEntity(spec *EntitySpec) (*compiledEntity, error) {
+	var err error
+
+	entity := &compiledEntity{
+		spec:          spec,
+		childEntities: make(map[string]*compiledEntity, len(spec.ChildEntities)),
+	}
+
+	if entity.path, err = parseTemplate(spec.ExternalID+".path", spec.Path); err != nil {
+		return nil, err
+	}
+
+	if entity.query, err = parseTemplates(spec.ExternalID+".query", spec.Query); err != nil {
+		return nil, err
+	}
+
+	if entity.headers, err = parseTemplates(spec.ExternalID+".headers", spec.Headers); err != nil {
+		return nil, err
+	}
+
+	if spec.Body != "" {
+		if entity.body, err = parseTemplate(spec.ExternalID+".body", spec.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	if entity.objectsPath, err = parseJSONPath(spec.ExternalID+".objectsPath", spec.ObjectsPath); err != nil {
+		return nil, err
+	}
+
+	switch spec.Pagination.Type {
+	case PaginationNextURL, PaginationCursor:
+		if spec.Pagination.NextPath == "" {
+			return nil, fmt.Errorf("%s.pagination.nextPath is required for pagination type %s",
+				spec.ExternalID, spec.Pagination.Type)
+		}
+
+		if entity.nextPath, err = parseJSONPath(spec.ExternalID+".pagination.nextPath", spec.Pagination.NextPath); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range spec.ChildEntities {
+		childEntity, err := compileEntity(&spec.ChildEntities[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s.childEntities.%w", spec.ExternalID, err)
+		}
+
+		entity.childEntities[spec.ChildEntities[i].ExternalID] = childEntity
+	}
+
+	return entity, nil
+}
+
+// parseTemplate parses the given template text.
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s is an invalid template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// parseTemplates parses the given template texts.
+func parseTemplates(name string, texts map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(texts))
+
+	for key, text := range texts {
+		tmpl, err := parseTemplate(name+"."+key, text)
+		if err != nil {
+			return nil, err
+		}
+
+		templates[key] = tmpl
+	}
+
+	return templates, nil
+}
+
+// parseJSONPath parses the given JSONPath.
+func parseJSONPath(name, path string) (gval.Evaluable, error) {
+	evaluable, err := jsonpath.New(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s is an invalid JSONPath: %w", name, err)
+	}
+
+	return evaluable, nil
+}
+
+// executeTemplate executes the given template with the given data.
+func executeTemplate(tmpl *template.Template, data *templateData) (string, error) {
+	var b strings.Builder
+
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// templateData is the data templates are executed with.
+type templateData struct {
+	// PageSize is the requested page size.
+	PageSize int64
+
+	// Parent is the JSON object of the parent object, for child entities.
+	Parent map[string]any
+}
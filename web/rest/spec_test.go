@@ -0,0 +1,87 @@
+// Copyright 2026 SGNL.ai, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/sgnl-ai/adapter-framework/pkg/config"
+)
+
+func TestSpecValidate(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		wantErr string
+	}{
+		"valid": {
+			data: `{"entities": [{"externalId": "users", "path": "/api/users", "childEntities": [` +
+				`{"externalId": "groups", "path": "/api/users/{{.Parent.id}}/groups"}]}]}`,
+		},
+		"invalid_header": {
+			data:    `{"headers": {"X-Tenant": "{{.Tenant"}, "entities": [{"externalId": "users", "path": "/api/users"}]}`,
+			wantErr: "$: headers.X-Tenant is an invalid template: template: headers.X-Tenant:1: unclosed action",
+		},
+		"invalid_path": {
+			data:    `{"entities": [{"externalId": "users", "path": "/api/{{.Parent.id"}]}`,
+			wantErr: "$: entities.users.path is an invalid template: template: users.path:1: unclosed action",
+		},
+		"invalid_child_entity_objects_path": {
+			data: `{"entities": [{"externalId": "users", "path": "/api/users", "childEntities": [` +
+				`{"externalId": "groups", "path": "/api/groups", "objectsPath": "$["}]}]}`,
+			wantErr: "$: entities.users.childEntities.groups.objectsPath is an invalid JSONPath: " +
+				"parsing error: $[\t:1:3 - 1:3 unexpected EOF while scanning extensions",
+		},
+		"missing_next_path": {
+			data:    `{"entities": [{"externalId": "users", "path": "/api/users", "pagination": {"type": "cursor"}}]}`,
+			wantErr: "$: entities.users.pagination.nextPath is required for pagination type cursor",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec, err := config.Parse[Spec]([]byte(tc.data))
+
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("Expected error %q, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if spec.compiled == nil {
+				t.Fatal("Expected the spec to be compiled when parsed")
+			}
+
+			if _, found := spec.compiled.entities["users"].childEntities["groups"]; !found {
+				t.Error("Expected the child entity to be compiled")
+			}
+
+			// An identical spec is compiled only once.
+			other, err := config.Parse[Spec]([]byte(tc.data))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if other.compiled != spec.compiled {
+				t.Error("Expected an identical spec to share the compiled spec")
+			}
+		})
+	}
+}